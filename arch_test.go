@@ -0,0 +1,19 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fe3dback/go-arch-lint/pkg/archlint"
+)
+
+func TestArchitecture(t *testing.T) {
+	result, err := archlint.Check(context.Background(), archlint.Options{
+		ProjectPath: "./",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.Messages())
+}
@@ -2,93 +2,87 @@ package container
 
 import (
 	"github.com/fe3dback/go-arch-lint/internal/services/checker"
-	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
-	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
-	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/diff"
+	"github.com/fe3dback/go-arch-lint/internal/services/graph"
+	"github.com/fe3dback/go-arch-lint/internal/services/metrics"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
-	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
 	"github.com/fe3dback/go-arch-lint/internal/services/render/code"
 	"github.com/fe3dback/go-arch-lint/internal/services/schema"
 	specassembler "github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
-	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
-	specvalidator "github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/migrator"
+	"github.com/fe3dback/go-arch-lint/pkg/archlint"
 )
 
-func (c *Container) provideSpecAssembler() *specassembler.Assembler {
-	return specassembler.NewAssembler(
-		c.provideYamlSpecProvider(),
-		c.provideSpecValidator(),
-		c.providePathResolver(),
-	)
-}
-
-func (c *Container) provideSpecValidator() *specvalidator.Validator {
-	return specvalidator.NewValidator(
-		c.providePathResolver(),
+// provideLinter return services graph of linter, same graph is
+// used by public library API, so CLI and library can`t diverge
+func (c *Container) provideLinter() *archlint.Linter {
+	return archlint.NewLinter(
+		c.flags.ArchFormat,
+		c.flags.UseColors,
 	)
 }
 
-func (c *Container) provideYamlSpecProvider() *decoder.Decoder {
-	return decoder.NewDecoder(
-		c.provideSourceCodeReferenceResolver(),
-		c.provideJsonSchemaProvider(),
+// providePlainLinter is used for outputs outside
+// of terminal (like html report), it's never colorized
+func (c *Container) providePlainLinter() *archlint.Linter {
+	return archlint.NewLinter(
+		c.flags.ArchFormat,
+		false,
 	)
 }
 
-func (c *Container) providePathResolver() *path.Resolver {
-	return path.NewResolver()
-}
-
-func (c *Container) provideSourceCodeReferenceResolver() *reference.Resolver {
-	return reference.NewResolver()
+func (c *Container) provideSpecAssembler() *specassembler.Assembler {
+	return c.provideLinter().SpecAssembler()
 }
 
 func (c *Container) provideReferenceRender() *code.Render {
-	return code.NewRender(
-		c.provideColorPrinter(),
-	)
+	return c.provideLinter().ReferenceRender()
 }
 
 func (c *Container) provideSpecChecker() *checker.CompositeChecker {
-	return checker.NewCompositeChecker(
-		c.provideSpecImportsChecker(),
-		c.provideSpecDeepScanChecker(),
-	)
+	return c.provideLinter().SpecChecker()
+}
+
+func (c *Container) providePlainSpecChecker() *checker.CompositeChecker {
+	return c.providePlainLinter().SpecChecker()
 }
 
 func (c *Container) provideSpecImportsChecker() *checker.Imports {
-	return checker.NewImport(
-		c.provideProjectFilesResolver(),
-	)
+	return c.provideLinter().ImportsChecker()
 }
 
 func (c *Container) provideSpecDeepScanChecker() *checker.DeepScan {
-	return checker.NewDeepScan(
-		c.provideProjectFilesResolver(),
-		c.provideReferenceRender(),
-	)
+	return c.provideLinter().DeepScanChecker()
 }
 
 func (c *Container) provideProjectFilesResolver() *resolver.Resolver {
-	return resolver.NewResolver(
-		c.provideProjectFilesScanner(),
-		c.provideProjectFilesHolder(),
-	)
+	return c.provideLinter().ProjectFilesResolver()
 }
 
-func (c *Container) provideProjectFilesScanner() *scanner.Scanner {
-	return scanner.NewScanner()
+func (c *Container) provideProjectInfoAssembler() *info.Assembler {
+	return c.provideLinter().ProjectInfoAssembler()
 }
 
-func (c *Container) provideProjectFilesHolder() *holder.Holder {
-	return holder.NewHolder()
+func (c *Container) provideMetricsCalculator() *metrics.Calculator {
+	return metrics.NewCalculator(
+		c.provideProjectFilesResolver(),
+	)
 }
 
-func (c *Container) provideProjectInfoAssembler() *info.Assembler {
-	return info.NewAssembler()
+func (c *Container) provideGraphRenderer() *graph.Renderer {
+	return graph.NewRenderer()
 }
 
 func (c *Container) provideJsonSchemaProvider() *schema.Provider {
 	return schema.NewProvider()
 }
+
+func (c *Container) provideSpecMigrator() *migrator.Migrator {
+	return migrator.NewMigrator()
+}
+
+func (c *Container) provideDiffer() *diff.Differ {
+	// same as `diff -u`
+	return diff.NewDiffer(3)
+}
@@ -59,6 +59,18 @@ func (c *Container) CommandRoot() *cobra.Command {
 				return fmt.Errorf("unknown output-type: %s", flags.OutputType)
 			}
 
+			archFormatIsValid := flags.ArchFormat == models.ArchFormatAuto
+			for _, validValue := range models.ArchFormatValues {
+				if flags.ArchFormat == validValue {
+					archFormatIsValid = true
+					break
+				}
+			}
+
+			if !archFormatIsValid {
+				return fmt.Errorf("unknown arch-format: %s", flags.ArchFormat)
+			}
+
 			// save global flags for another child commands
 			c.flags = flags
 			return nil
@@ -73,6 +85,7 @@ func (c *Container) CommandRoot() *cobra.Command {
 		"output-type",
 		models.OutputTypeJSON,
 	))
+	rootCmd.PersistentFlags().StringVar(&flags.ArchFormat, "arch-format", flags.ArchFormat, fmt.Sprintf("format of arch file, variants: [%s] (default: detected by file extension)", strings.Join(models.ArchFormatValues, ", ")))
 
 	// apply sub commands
 	for _, subCmd := range c.commands() {
@@ -111,16 +124,31 @@ func (c *Container) commands() []*cobra.Command {
 		unwrap(c.commandCheck()),
 		unwrap(c.commandMapping()),
 		unwrap(c.commandGraph()),
+		unwrap(c.commandMetrics()),
+		unwrap(c.commandReport()),
+		unwrap(c.commandMigrate()),
+		unwrap(c.commandModules()),
 	}
 
-	list := make([]*cobra.Command, 0, len(executors))
-	for _, x := range executors {
-		x := x
+	wrap := func(x exec) *cobra.Command {
 		x.cmd.RunE = func(activeCmd *cobra.Command, _ []string) error {
 			return c.ProvideRenderer().RenderModel(x.runE(activeCmd))
 		}
-		list = append(list, x.cmd)
+
+		return x.cmd
 	}
 
+	list := make([]*cobra.Command, 0, len(executors))
+	for _, x := range executors {
+		list = append(list, wrap(x))
+	}
+
+	// command groups
+	deepscanCmd := c.commandDeepscan()
+	deepscanCmd.AddCommand(
+		wrap(unwrap(c.commandDeepscanInventory())),
+	)
+
+	list = append(list, deepscanCmd)
 	return list
 }
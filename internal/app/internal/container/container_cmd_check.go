@@ -2,6 +2,7 @@ package container
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/operations/check"
@@ -25,6 +26,13 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
 	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
 	cmd.PersistentFlags().IntVar(&in.MaxWarnings, "max-warnings", in.MaxWarnings, "max number of warnings to output")
+	cmd.PersistentFlags().StringSliceVar(&in.Checks, "checks", in.Checks, fmt.Sprintf(
+		"run only selected checkers [%s] (default all)",
+		strings.Join(models.CheckersValues, ","),
+	))
+	cmd.PersistentFlags().BoolVar(&in.AllChecks, "all-checks", in.AllChecks, "run all checkers, even when previous checker found warnings")
+	cmd.PersistentFlags().BoolVar(&in.ShowTimings, "timings", in.ShowTimings, "show execution time of every checker")
+	cmd.PersistentFlags().StringVar(&in.Owner, "owner", in.Owner, "show only warnings related to components of this owner (example: team-payments)")
 
 	return cmd, func(act *cobra.Command) (any, error) {
 		const warningsRangeMin = 1
@@ -39,6 +47,24 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 			)
 		}
 
+		for _, checkName := range in.Checks {
+			hasValidName := false
+			for _, validName := range models.CheckersValues {
+				if checkName == validName {
+					hasValidName = true
+					break
+				}
+			}
+
+			if !hasValidName {
+				return nil, fmt.Errorf(
+					"unknown check '%s', available: [%s]",
+					checkName,
+					strings.Join(models.CheckersValues, ", "),
+				)
+			}
+		}
+
 		return c.commandCheckOperation().Behave(act.Context(), in)
 	}
 }
@@ -0,0 +1,50 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/deepscanInventory"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandDeepscan() *cobra.Command {
+	return &cobra.Command{
+		Use:     "deepscan",
+		Aliases: []string{"ds"},
+		Short:   "dependency injections analyse tools",
+		Long:    "tools for review of dependency injections, found by deepscan in project components",
+		RunE: func(act *cobra.Command, _ []string) error {
+			return act.Help()
+		},
+	}
+}
+
+func (c *Container) commandDeepscanInventory() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:     "inventory",
+		Aliases: []string{"inv"},
+		Short:   "list all deepscan gates with injected implementations",
+		Long:    "display every gate (interface typed function param or struct field) found by deepscan, with all implementations injected into it, independent of arch rules",
+	}
+
+	in := models.CmdDeepscanInventoryIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		CSVFile:     "",
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().StringVar(&in.CSVFile, "csv", in.CSVFile, "export inventory into csv file")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandDeepscanInventoryOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandDeepscanInventoryOperation() *deepscanInventory.Operation {
+	return deepscanInventory.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecAssembler(),
+		c.provideSpecDeepScanChecker(),
+	)
+}
@@ -2,6 +2,7 @@ package container
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
@@ -13,7 +14,7 @@ func (c *Container) commandGraph() (*cobra.Command, runner) {
 	cmd := &cobra.Command{
 		Use:     "graph",
 		Aliases: []string{"g"},
-		Short:   "output dependencies graph as svg file",
+		Short:   "output dependencies graph as image or diagram source",
 		Long:    "display mapping table between project files and arch components",
 	}
 
@@ -21,23 +22,78 @@ func (c *Container) commandGraph() (*cobra.Command, runner) {
 		ProjectPath:    models.DefaultProjectPath,
 		ArchFile:       models.DefaultArchFileName,
 		Type:           models.GraphTypeFlow,
+		Format:         models.GraphFormatSVG,
 		OutFile:        "./go-arch-lint-graph.svg",
-		Focus:          "",
+		Focus:          []string{},
+		FocusReverse:   false,
+		Depth:          0,
+		Groups:         []string{},
 		IncludeVendors: false,
 		ExportD2:       false,
+		Stdout:         false,
+		Theme:          models.DefaultGraphTheme,
+		Sketch:         true,
+		Layout:         models.GraphLayoutDagre,
+		Padding:        models.DefaultGraphPadding,
 	}
 
 	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
 	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
 	cmd.PersistentFlags().StringVarP(&in.Type, "type", "t", in.Type, fmt.Sprintf("render graph type [%s]", strings.Join(models.GraphTypesValues, ",")))
-	cmd.PersistentFlags().StringVar(&in.OutFile, "out", in.OutFile, "svg graph output file")
-	cmd.PersistentFlags().StringVar(&in.Focus, "focus", in.Focus, "render only specified component (should match component name exactly)")
+	cmd.PersistentFlags().StringVarP(&in.Format, "format", "f", in.Format, fmt.Sprintf("graph output format [%s]", strings.Join(models.GraphFormatsValues, ",")))
+	cmd.PersistentFlags().StringVar(&in.OutFile, "out", in.OutFile, "graph output file (extension is changed by format, when not specified)")
+	cmd.PersistentFlags().StringSliceVar(&in.Focus, "focus", in.Focus, "render only specified components and their dependencies (component name or glob, can be specified multiple times)")
+	cmd.PersistentFlags().BoolVar(&in.FocusReverse, "focus-reverse", in.FocusReverse, "render focused components and everything that may depend on them (instead of their dependencies)")
+	cmd.PersistentFlags().IntVar(&in.Depth, "depth", in.Depth, "limit focus traversal to N levels from focused components (0 = unlimited)")
+	cmd.PersistentFlags().StringSliceVar(&in.Groups, "group", in.Groups, "collapse components matched by glob into single node, named by glob prefix (example: 'services/*')")
 	cmd.PersistentFlags().BoolVarP(&in.IncludeVendors, "include-vendors", "r", in.IncludeVendors, "include vendor dependencies (from \"canUse\" block)?")
 	cmd.PersistentFlags().BoolVar(&in.ExportD2, "d2", in.ExportD2, "output raw d2 definitions to stdout (from which svg is generated)")
+	cmd.PersistentFlags().BoolVar(&in.Stdout, "stdout", in.Stdout, "output graph definitions in selected format to stdout, instead of writing out file (not available for images)")
+	cmd.PersistentFlags().StringVar(&in.Theme, "theme", in.Theme, "d2 theme name or id for images (like 'neutral-default', 'dark-mauve', '200')")
+	cmd.PersistentFlags().BoolVar(&in.Sketch, "sketch", in.Sketch, "render graph in hand-drawn sketch style")
+	cmd.PersistentFlags().StringVar(&in.Layout, "layout", in.Layout, fmt.Sprintf("images layout engine [%s]", strings.Join(models.GraphLayoutsValues, ",")))
+	cmd.PersistentFlags().IntVar(&in.Padding, "padding", in.Padding, "images padding in pixels")
 
 	return cmd, func(act *cobra.Command) (any, error) {
 		in.OutputType = c.flags.OutputType
 
+		ext, validFormat := models.GraphFormatsExtensions[in.Format]
+		if !validFormat {
+			return "", fmt.Errorf(
+				"invalid format '%s', available: [%s]",
+				in.Format,
+				strings.Join(models.GraphFormatsValues, ", "),
+			)
+		}
+
+		if in.Stdout && models.IsGraphImageFormat(in.Format) {
+			return "", fmt.Errorf("flag '--stdout' can`t be used with image format '%s'", in.Format)
+		}
+
+		if in.Layout != models.GraphLayoutDagre && in.Layout != models.GraphLayoutELK {
+			return "", fmt.Errorf(
+				"invalid layout '%s', available: [%s]",
+				in.Layout,
+				strings.Join(models.GraphLayoutsValues, ", "),
+			)
+		}
+
+		if in.Padding < 0 {
+			return "", fmt.Errorf("flag '--padding' should be positive number or 0")
+		}
+
+		if in.Depth < 0 {
+			return "", fmt.Errorf("flag '--depth' should be positive number or 0 (unlimited)")
+		}
+
+		if len(in.Focus) == 0 && (in.FocusReverse || in.Depth > 0) {
+			return "", fmt.Errorf("flags '--focus-reverse' and '--depth' can be used only with '--focus'")
+		}
+
+		if !act.Flags().Changed("out") {
+			in.OutFile = strings.TrimSuffix(in.OutFile, filepath.Ext(in.OutFile)) + "." + ext
+		}
+
 		return c.commandGraphOperation().Behave(act.Context(), in)
 	}
 }
@@ -46,5 +102,6 @@ func (c *Container) commandGraphOperation() *graph.Operation {
 	return graph.NewOperation(
 		c.provideSpecAssembler(),
 		c.provideProjectInfoAssembler(),
+		c.provideGraphRenderer(),
 	)
 }
@@ -0,0 +1,51 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/metrics"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandMetrics() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "architecture metrics of components",
+		Long:  "calculate coupling, instability, abstractness and distance from the main sequence for each component",
+	}
+
+	in := models.CmdMetricsIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		MaxDistance: 1,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().Float64Var(&in.MaxDistance, "max-distance", in.MaxDistance, "fail when any component distance from the main sequence is greater than this value")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		const distanceRangeMin = 0
+		const distanceRangeMax = 1
+
+		if in.MaxDistance < distanceRangeMin || in.MaxDistance > distanceRangeMax {
+			return nil, fmt.Errorf(
+				"flag '%s' should by in range [%d .. %d]",
+				"max-distance",
+				distanceRangeMin,
+				distanceRangeMax,
+			)
+		}
+
+		return c.commandMetricsOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandMetricsOperation() *metrics.Operation {
+	return metrics.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecAssembler(),
+		c.provideMetricsCalculator(),
+	)
+}
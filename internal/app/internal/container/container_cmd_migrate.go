@@ -0,0 +1,50 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/migrate"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandMigrate() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "migrate arch file to latest version",
+		Long:  "rewrite arch file from older version into latest one, with same linter behavior (comments and keys order is kept)",
+	}
+
+	in := models.CmdMigrateIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		ToVersion:   models.SupportedVersionMax,
+		DryRun:      false,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().IntVar(&in.ToVersion, "to", in.ToVersion, "target arch file version")
+	cmd.PersistentFlags().BoolVar(&in.DryRun, "dry-run", in.DryRun, "do not write arch file, only output unified diff")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		if in.ToVersion < models.SupportedVersionMin || in.ToVersion > models.SupportedVersionMax {
+			return nil, fmt.Errorf(
+				"flag '%s' should by in range [%d .. %d]",
+				"to",
+				models.SupportedVersionMin,
+				models.SupportedVersionMax,
+			)
+		}
+
+		return c.commandMigrateOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandMigrateOperation() *migrate.Operation {
+	return migrate.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecMigrator(),
+		c.provideDiffer(),
+	)
+}
@@ -0,0 +1,35 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/modules"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandModules() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "modules",
+		Short: "go.mod modules and components allowed to use them",
+		Long:  "display go.mod requirements with list of components, that allowed to import them, and requirements not allowed for any component",
+	}
+
+	in := models.CmdModulesIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandModulesOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandModulesOperation() *modules.Operation {
+	return modules.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecAssembler(),
+		c.provideSpecImportsChecker(),
+	)
+}
@@ -0,0 +1,49 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/report"
+	"github.com/fe3dback/go-arch-lint/internal/view"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandReport() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:     "report",
+		Aliases: []string{"r"},
+		Short:   "static html report of architecture and warnings",
+		Long:    "build self-contained html site with components graph, files mapping, check warnings and spec notices",
+	}
+
+	in := models.CmdReportIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		HTMLDir:     "",
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().StringVar(&in.HTMLDir, "html", in.HTMLDir, "output directory for html report (will be created, if not exist)")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		if in.HTMLDir == "" {
+			return "", fmt.Errorf("flag '--html' is required")
+		}
+
+		return c.commandReportOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandReportOperation() *report.Operation {
+	return report.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecAssembler(),
+		c.providePlainSpecChecker(),
+		c.provideProjectFilesResolver(),
+		c.providePlainLinter().ReferenceRender(),
+		c.provideGraphRenderer(),
+		view.ReportHTML,
+	)
+}
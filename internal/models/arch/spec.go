@@ -14,6 +14,8 @@ type (
 		ModuleName          common.Referable[string]
 		Allow               Allow
 		Components          []Component
+		Vendors             []Vendor
+		GoModules           []common.GoModule
 		Exclude             []common.Referable[models.ResolvedPath]
 		ExcludeFilesMatcher []common.Referable[*regexp.Regexp]
 		Integrity           Integrity
@@ -22,23 +24,53 @@ type (
 	Allow struct {
 		DepOnAnyVendor           common.Referable[bool]
 		DeepScan                 common.Referable[bool]
+		DeepScanDepth            common.Referable[int]
+		AllChecks                common.Referable[bool]
 		IgnoreNotFoundComponents common.Referable[bool]
+		LowLevelFeatures         common.Referable[bool]
 	}
 
 	Component struct {
 		Name                  common.Referable[string]
 		DeepScan              common.Referable[bool]
+		Private               common.Referable[bool]
+		Owner                 common.Referable[string] // from spec or CODEOWNERS, empty when not defined
+		Description           common.Referable[string]
+		Tags                  []common.Referable[string]
 		ResolvedPaths         []common.Referable[models.ResolvedPath]
 		AllowedProjectImports []common.Referable[models.ResolvedPath]
+		DeniedProjectImports  []common.Referable[DeniedImport]
 		AllowedVendorGlobs    []common.Referable[models.Glob]
+		ForbiddenVendorGlobs  []common.Referable[models.Glob]
+		AllowedModules        []common.Referable[models.Glob]
+		ForbiddenStdGlobs     []common.Referable[models.Glob]
+		AllowedFeatures       []common.Referable[string]
 		MayDependOn           []common.Referable[string]
 		CanUse                []common.Referable[string]
 		SpecialFlags          SpecialFlags
 	}
 
+	// DeniedImport is package of other component, that can't be imported,
+	// because component is not in allowedImporters list of this other component
+	DeniedImport struct {
+		ComponentName string
+		ResolvedPath  models.ResolvedPath
+	}
+
+	// Vendor with go modules rules (only
+	// vendors defined by `module` is here)
+	Vendor struct {
+		Name              common.Referable[string]
+		Modules           []common.Referable[models.Glob]
+		Version           common.Referable[string]
+		Forbidden         common.Referable[bool]
+		AllowLocalReplace common.Referable[bool]
+	}
+
 	SpecialFlags struct {
 		AllowAllProjectDeps common.Referable[bool]
 		AllowAllVendorDeps  common.Referable[bool]
+		RestrictModules     common.Referable[bool] // vendor imports is limited by AllowedModules
 	}
 
 	Integrity struct {
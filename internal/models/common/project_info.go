@@ -6,5 +6,23 @@ type (
 		GoArchFilePath string
 		GoModFilePath  string
 		ModuleName     string
+		GoModules      []GoModule
+	}
+
+	// GoModule is required module from project go.mod
+	GoModule struct {
+		Path      string
+		Version   string
+		Indirect  bool
+		Reference Reference // require line in go.mod
+		Replace   *GoModuleReplace
+	}
+
+	// GoModuleReplace is replace directive of required module
+	GoModuleReplace struct {
+		Path      string
+		Version   string
+		Local     bool // replaced with local directory
+		Reference Reference
 	}
 )
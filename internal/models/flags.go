@@ -11,12 +11,27 @@ var OutputTypeValues = []string{
 	OutputTypeJSON,
 }
 
+const (
+	ArchFormatAuto ArchFormat = ""
+	ArchFormatYAML ArchFormat = "yaml"
+	ArchFormatJSON ArchFormat = "json"
+	ArchFormatTOML ArchFormat = "toml"
+)
+
+var ArchFormatValues = []string{
+	ArchFormatYAML,
+	ArchFormatJSON,
+	ArchFormatTOML,
+}
+
 type (
 	OutputType = string
+	ArchFormat = string
 
 	FlagsRoot struct {
 		UseColors         bool
 		OutputType        OutputType
 		OutputJsonOneLine bool
+		ArchFormat        ArchFormat
 	}
 )
@@ -8,6 +8,25 @@ const (
 	DefaultGoModFileName = "go.mod"
 )
 
+// DefaultDeepScanDepth is how many nested function calls deepscan
+// will follow, when resolving implementation from interface value
+const DefaultDeepScanDepth = 3
+
+// DefaultArchFileNames is list of arch file names, that will be
+// searched in project, when arch file is not specified explicitly
+var DefaultArchFileNames = []string{
+	DefaultArchFileName,
+	".go-arch-lint.toml",
+	".go-arch-lint.json",
+}
+
+// component visibility, private component can be imported
+// only by code from parent directory of component packages
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
 const (
 	SupportedVersionMin = 1
 	SupportedVersionMax = 3
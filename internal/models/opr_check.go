@@ -1,30 +1,83 @@
 package models
 
-import "github.com/fe3dback/go-arch-lint/internal/models/common"
+import (
+	"time"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+const (
+	CheckerImports    = "imports"
+	CheckerDeepScan   = "deepscan"
+	CheckerFeatures   = "features"
+	CheckerVisibility = "visibility"
+)
+
+var CheckersValues = []string{
+	CheckerImports,
+	CheckerDeepScan,
+	CheckerFeatures,
+	CheckerVisibility,
+}
+
+// low level features, checked by features checker
+const (
+	FeatureCgo      = "cgo"
+	FeatureUnsafe   = "unsafe"
+	FeatureReflect  = "reflect"
+	FeatureLinkname = "linkname"
+	FeatureEmbed    = "embed"
+)
 
 type (
 	CmdCheckIn struct {
 		ProjectPath string
 		ArchFile    string
 		MaxWarnings int
+		Checks      []string
+		AllChecks   bool
+		ShowTimings bool
+		Owner       string // show only warnings, related to components of this owner
+	}
+
+	// CheckSelection describe which checkers should be executed.
+	// Empty Checks list means all available checkers
+	CheckSelection struct {
+		Checks    []string
+		AllChecks bool // run all checkers, even when previous checker found warnings
+	}
+
+	// CheckerRun is execution stats of one checker
+	CheckerRun struct {
+		Name     string
+		Ran      bool
+		Duration time.Duration
 	}
 
 	CmdCheckOut struct {
-		DocumentNotices        []CheckNotice                `json:"ExecutionWarnings"`
-		ArchHasWarnings        bool                         `json:"ArchHasWarnings"`
-		ArchWarningsDependency []CheckArchWarningDependency `json:"ArchWarningsDeps"`
-		ArchWarningsMatch      []CheckArchWarningMatch      `json:"ArchWarningsNotMatched"`
-		ArchWarningsDeepScan   []CheckArchWarningDeepscan   `json:"ArchWarningsDeepScan"`
-		OmittedCount           int                          `json:"OmittedCount"`
-		ModuleName             string                       `json:"ModuleName"`
-		Qualities              []CheckQuality               `json:"Qualities"`
+		DocumentNotices            []CheckNotice                    `json:"ExecutionWarnings"`
+		ArchHasWarnings            bool                             `json:"ArchHasWarnings"`
+		ArchWarningsDependency     []CheckArchWarningDependency     `json:"ArchWarningsDeps"`
+		ArchWarningsMatch          []CheckArchWarningMatch          `json:"ArchWarningsNotMatched"`
+		ArchWarningsDeepScan       []CheckArchWarningDeepscan       `json:"ArchWarningsDeepScan"`
+		ArchWarningsDeepScanVendor []CheckArchWarningDeepscanVendor `json:"ArchWarningsDeepScanVendor"`
+		ArchWarningsModule         []CheckArchWarningModule         `json:"ArchWarningsModules"`
+		ArchWarningsFeature        []CheckArchWarningFeature        `json:"ArchWarningsFeatures"`
+		ArchWarningsVisibility     []CheckArchWarningVisibility     `json:"ArchWarningsVisibility"`
+		OmittedCount               int                              `json:"OmittedCount"`
+		ModuleName                 string                           `json:"ModuleName"`
+		Qualities                  []CheckQuality                   `json:"Qualities"`
+		ShowTimings                bool                             `json:"-"` // show checkers execution time in text output
 	}
 
 	CheckQuality struct {
-		ID   string `json:"ID"`
-		Used bool   `json:"Used"`
-		Name string `json:"-"`
-		Hint string `json:"-"`
+		ID       string        `json:"ID"`
+		Used     bool          `json:"Used"`
+		Checker  string        `json:"Checker"`  // checker, that provide this quality
+		Ran      bool          `json:"Ran"`      // checker was executed
+		Duration time.Duration `json:"Duration"` // checker execution time
+		Name     string        `json:"-"`
+		Hint     string        `json:"-"`
 	}
 
 	CheckNotice struct {
@@ -36,11 +89,16 @@ type (
 	}
 
 	CheckArchWarningDependency struct {
-		ComponentName      string           `json:"ComponentName"`
-		FileRelativePath   string           `json:"FileRelativePath"`
-		FileAbsolutePath   string           `json:"FileAbsolutePath"`
-		ResolvedImportName string           `json:"ResolvedImportName"`
-		Reference          common.Reference `json:"Reference"`
+		ComponentName          string           `json:"ComponentName"`
+		ComponentOwner         string           `json:"ComponentOwner,omitempty"`
+		ImportedComponentName  string           `json:"ImportedComponentName,omitempty"` // empty for vendor and std imports
+		ImportedComponentOwner string           `json:"ImportedComponentOwner,omitempty"`
+		FileRelativePath       string           `json:"FileRelativePath"`
+		FileAbsolutePath       string           `json:"FileAbsolutePath"`
+		ResolvedImportName     string           `json:"ResolvedImportName"`
+		Reason                 string           `json:"Reason,omitempty"` // empty, when import is not allowed by mayDependOn (or canUse) rules
+		Reference              common.Reference `json:"Reference"`
+		SourceCodePreview      []byte           `json:"-"`
 	}
 
 	CheckArchWarningMatch struct {
@@ -49,6 +107,34 @@ type (
 		Reference        common.Reference `json:"-"`
 	}
 
+	// CheckArchWarningModule is go.mod require, not matched to vendor rules
+	CheckArchWarningModule struct {
+		VendorName string           `json:"VendorName"` // errors
+		ModulePath string           `json:"ModulePath"` // github.com/pkg/errors
+		Version    string           `json:"Version"`    // v0.9.1
+		Reason     string           `json:"Reason"`     // module is forbidden
+		Reference  common.Reference `json:"Reference"`  // go.mod:12
+	}
+
+	// CheckArchWarningFeature is low level feature usage, not allowed for component
+	CheckArchWarningFeature struct {
+		ComponentName    string           `json:"ComponentName"`    // platform
+		Feature          string           `json:"Feature"`          // linkname
+		FileRelativePath string           `json:"FileRelativePath"` // /internal/platform/time.go
+		FileAbsolutePath string           `json:"FileAbsolutePath"`
+		Reference        common.Reference `json:"Reference"` // import or directive line
+	}
+
+	// CheckArchWarningVisibility is private component, used outside of its parent
+	CheckArchWarningVisibility struct {
+		ComponentName        string           `json:"ComponentName"`        // app
+		PrivateComponentName string           `json:"PrivateComponentName"` // storage-db
+		Reason               string           `json:"Reason"`               // type 'db.Conn' exposed in 'NewRepo'
+		FileRelativePath     string           `json:"FileRelativePath"`     // /internal/app/app.go
+		FileAbsolutePath     string           `json:"FileAbsolutePath"`
+		Reference            common.Reference `json:"Reference"` // import or exported API line
+	}
+
 	CheckArchWarningDeepscan struct {
 		Gate       DeepscanWarningGate       `json:"Gate"`
 		Dependency DeepscanWarningDependency `json:"Dependency"`
@@ -71,15 +157,35 @@ type (
 		SourceCodePreview []byte           `json:"-"`
 	}
 
+	CheckArchWarningDeepscanVendor struct {
+		Gate   DeepscanWarningGate   `json:"Gate"`
+		Vendor DeepscanWarningVendor `json:"Vendor"`
+		Target DeepscanWarningTarget `json:"Target"`
+	}
+
+	DeepscanWarningVendor struct {
+		ImportPath        string           `json:"ImportPath"`   // database/sql
+		Name              string           `json:"Name"`         // sql.DB
+		InjectionAST      string           `json:"InjectionAST"` // c.provideDB()
+		Injection         common.Reference `json:"Injection"`    // internal/app/internal/container/cmd_mapping.go:15
+		InjectionPath     string           `json:"-"`            // internal/app/internal/container/cmd_mapping.go:15
+		SourceCodePreview []byte           `json:"-"`
+	}
+
 	DeepscanWarningTarget struct {
 		Definition   common.Reference `json:"Definition"`
 		RelativePath string           `json:"-"` // internal/app/internal/container/cmd_mapping.go:15
 	}
 
 	CheckResult struct {
-		DependencyWarnings []CheckArchWarningDependency
-		MatchWarnings      []CheckArchWarningMatch
-		DeepscanWarnings   []CheckArchWarningDeepscan
+		DependencyWarnings     []CheckArchWarningDependency
+		MatchWarnings          []CheckArchWarningMatch
+		DeepscanWarnings       []CheckArchWarningDeepscan
+		DeepscanVendorWarnings []CheckArchWarningDeepscanVendor
+		ModuleWarnings         []CheckArchWarningModule
+		FeatureWarnings        []CheckArchWarningFeature
+		VisibilityWarnings     []CheckArchWarningVisibility
+		Runs                   []CheckerRun
 	}
 )
 
@@ -87,6 +193,10 @@ func (cr *CheckResult) Append(another CheckResult) {
 	cr.DependencyWarnings = append(cr.DependencyWarnings, another.DependencyWarnings...)
 	cr.MatchWarnings = append(cr.MatchWarnings, another.MatchWarnings...)
 	cr.DeepscanWarnings = append(cr.DeepscanWarnings, another.DeepscanWarnings...)
+	cr.DeepscanVendorWarnings = append(cr.DeepscanVendorWarnings, another.DeepscanVendorWarnings...)
+	cr.ModuleWarnings = append(cr.ModuleWarnings, another.ModuleWarnings...)
+	cr.FeatureWarnings = append(cr.FeatureWarnings, another.FeatureWarnings...)
+	cr.VisibilityWarnings = append(cr.VisibilityWarnings, another.VisibilityWarnings...)
 }
 
 func (cr *CheckResult) HasNotices() bool {
@@ -99,6 +209,18 @@ func (cr *CheckResult) HasNotices() bool {
 	if len(cr.DeepscanWarnings) > 0 {
 		return true
 	}
+	if len(cr.DeepscanVendorWarnings) > 0 {
+		return true
+	}
+	if len(cr.ModuleWarnings) > 0 {
+		return true
+	}
+	if len(cr.FeatureWarnings) > 0 {
+		return true
+	}
+	if len(cr.VisibilityWarnings) > 0 {
+		return true
+	}
 
 	return false
 }
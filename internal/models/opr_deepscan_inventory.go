@@ -0,0 +1,51 @@
+package models
+
+import "github.com/fe3dback/go-arch-lint/internal/models/common"
+
+type (
+	CmdDeepscanInventoryIn struct {
+		ProjectPath string
+		ArchFile    string
+		CSVFile     string
+	}
+
+	CmdDeepscanInventoryOut struct {
+		ModuleName           string                       `json:"ModuleName"`
+		CSVFile              string                       `json:"CSVFile"`
+		Gates                []DeepscanInventoryGate      `json:"Gates"`
+		Interfaces           []DeepscanInventoryInterface `json:"Interfaces"`
+		ImplementationsCount int                          `json:"ImplementationsCount"`
+		NotInjectedGates     []DeepscanInventoryGate      `json:"-"`
+		SingleImplementation []DeepscanInventoryInterface `json:"-"`
+	}
+
+	DeepscanInventoryGate struct {
+		ComponentName   string                            `json:"ComponentName"`   // operations
+		Name            string                            `json:"Name"`            // NewOperation (or Service.Repo for struct fields)
+		ParamName       string                            `json:"ParamName"`       // repo
+		Interface       string                            `json:"Interface"`       // Repository
+		InterfaceImport string                            `json:"InterfaceImport"` // example.com/project/internal/operations
+		Definition      common.Reference                  `json:"Definition"`
+		RelativePath    string                            `json:"-"` // /internal/operations/processor.go:12
+		Implementations []DeepscanInventoryImplementation `json:"Implementations"`
+	}
+
+	DeepscanInventoryImplementation struct {
+		ComponentName string           `json:"ComponentName"` // repository (empty for vendor types)
+		Import        string           `json:"Import"`        // example.com/project/internal/repository
+		IsVendor      bool             `json:"IsVendor"`      // implementation from vendor lib or stdlib
+		Name          string           `json:"Name"`          // repository.Memory
+		InjectionAST  string           `json:"InjectionAST"`  // repository.NewMemory()
+		Injection     common.Reference `json:"Injection"`
+		InjectionPath string           `json:"-"` // /internal/app/container.go:15
+	}
+
+	// DeepscanInventoryInterface is summary of all gates, that
+	// use the same interface
+	DeepscanInventoryInterface struct {
+		Name            string   `json:"Name"`            // Repository
+		Import          string   `json:"Import"`          // example.com/project/internal/operations
+		GatesCount      int      `json:"GatesCount"`      // how many gates use this interface
+		Implementations []string `json:"Implementations"` // unique injected types (repository.Memory)
+	}
+)
@@ -5,30 +5,104 @@ const (
 	GraphTypeDI   GraphType = "di"
 )
 
+const (
+	GraphFormatSVG      GraphFormat = "svg"
+	GraphFormatD2       GraphFormat = "d2"
+	GraphFormatDOT      GraphFormat = "dot"
+	GraphFormatMermaid  GraphFormat = "mermaid"
+	GraphFormatPlantUML GraphFormat = "plantuml"
+	GraphFormatPNG      GraphFormat = "png"
+	GraphFormatPDF      GraphFormat = "pdf"
+)
+
+const (
+	GraphLayoutDagre GraphLayout = "dagre"
+	GraphLayoutELK   GraphLayout = "elk"
+)
+
+const (
+	DefaultGraphTheme   = "neutral-default"
+	DefaultGraphPadding = 100
+)
+
 var GraphTypesValues = []string{
 	GraphTypeFlow,
 	GraphTypeDI,
 }
 
+var GraphFormatsValues = []string{
+	GraphFormatSVG,
+	GraphFormatD2,
+	GraphFormatDOT,
+	GraphFormatMermaid,
+	GraphFormatPlantUML,
+	GraphFormatPNG,
+	GraphFormatPDF,
+}
+
+var GraphLayoutsValues = []string{
+	GraphLayoutDagre,
+	GraphLayoutELK,
+}
+
+// GraphFormatsExtensions is default out file extensions for every graph format
+var GraphFormatsExtensions = map[GraphFormat]string{
+	GraphFormatSVG:      "svg",
+	GraphFormatD2:       "d2",
+	GraphFormatDOT:      "dot",
+	GraphFormatMermaid:  "mmd",
+	GraphFormatPlantUML: "puml",
+	GraphFormatPNG:      "png",
+	GraphFormatPDF:      "pdf",
+}
+
+// graphImageFormats is compiled from d2 definitions and
+// can't be printed to stdout
+var graphImageFormats = map[GraphFormat]struct{}{
+	GraphFormatSVG: {},
+	GraphFormatPNG: {},
+	GraphFormatPDF: {},
+}
+
 type (
-	GraphType = string
+	GraphType   = string
+	GraphFormat = string
+	GraphLayout = string
 
 	CmdGraphIn struct {
 		ProjectPath    string
 		ArchFile       string
 		Type           GraphType
+		Format         GraphFormat
 		OutFile        string
-		Focus          string
+		Focus          []string
+		FocusReverse   bool
+		Depth          int
+		Groups         []string
 		IncludeVendors bool
 		ExportD2       bool
+		Stdout         bool
+		Theme          string
+		Sketch         bool
+		Layout         GraphLayout
+		Padding        int
+		NodeLink       string // fmt pattern for component node links, like "#component-%s"
 		OutputType     OutputType
 	}
 
 	CmdGraphOut struct {
-		ProjectDirectory string `json:"ProjectDirectory"`
-		ModuleName       string `json:"ModuleName"`
-		OutFile          string `json:"OutFile"`
-		D2Definitions    string `json:"D2Definitions"`
-		ExportD2         bool   `json:"-"`
+		ProjectDirectory string      `json:"ProjectDirectory"`
+		ModuleName       string      `json:"ModuleName"`
+		OutFile          string      `json:"OutFile"`
+		Format           GraphFormat `json:"Format"`
+		D2Definitions    string      `json:"D2Definitions"`
+		Definitions      string      `json:"Definitions"`
+		ExportD2         bool        `json:"-"`
+		Stdout           bool        `json:"-"`
 	}
 )
+
+func IsGraphImageFormat(format GraphFormat) bool {
+	_, isImage := graphImageFormats[format]
+	return isImage
+}
@@ -29,11 +29,15 @@ type (
 
 	CmdMappingOutGrouped struct {
 		ComponentName string
+		Owner         string   `json:",omitempty"`
+		Description   string   `json:",omitempty"`
+		Tags          []string `json:",omitempty"`
 		FileNames     []string
 	}
 
 	CmdMappingOutList struct {
 		FileName      string
 		ComponentName string
+		Owner         string `json:",omitempty"`
 	}
 )
@@ -0,0 +1,28 @@
+package models
+
+type (
+	CmdMetricsIn struct {
+		ProjectPath string
+		ArchFile    string
+		MaxDistance float64
+	}
+
+	CmdMetricsOut struct {
+		ModuleName        string             `json:"ModuleName"`
+		MaxDistance       float64            `json:"MaxDistance"`
+		ThresholdExceeded bool               `json:"ThresholdExceeded"`
+		Components        []ComponentMetrics `json:"Components"`
+	}
+
+	ComponentMetrics struct {
+		ComponentName    string  `json:"ComponentName"`
+		Afferent         int     `json:"Afferent"`      // Ca: how many components depend on this one
+		Efferent         int     `json:"Efferent"`      // Ce: how many components this one depends on
+		Interfaces       int     `json:"Interfaces"`    // declared interface types
+		ConcreteTypes    int     `json:"ConcreteTypes"` // declared non interface types
+		Instability      float64 `json:"Instability"`   // I = Ce / (Ca + Ce)
+		Abstractness     float64 `json:"Abstractness"`  // A = Interfaces / (Interfaces + ConcreteTypes)
+		Distance         float64 `json:"Distance"`      // D = |A + I - 1|
+		ExceedsThreshold bool    `json:"ExceedsThreshold"`
+	}
+)
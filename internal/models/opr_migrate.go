@@ -0,0 +1,29 @@
+package models
+
+type (
+	CmdMigrateIn struct {
+		ProjectPath string
+		ArchFile    string
+		ToVersion   int
+		DryRun      bool
+	}
+
+	CmdMigrateOut struct {
+		ArchFile    string   `json:"ArchFile"`
+		FromVersion int      `json:"FromVersion"`
+		ToVersion   int      `json:"ToVersion"`
+		Changes     []string `json:"Changes"`
+		Diff        string   `json:"Diff"`
+		DryRun      bool     `json:"DryRun"`
+		Written     bool     `json:"Written"` // arch file is rewritten
+	}
+
+	// SpecMigration is result of arch file migration
+	// between versions
+	SpecMigration struct {
+		FromVersion int
+		ToVersion   int
+		SourceCode  []byte
+		Changes     []string // human-readable list of applied changes
+	}
+)
@@ -0,0 +1,23 @@
+package models
+
+type (
+	CmdModulesIn struct {
+		ProjectPath string
+		ArchFile    string
+	}
+
+	CmdModulesOut struct {
+		ModuleName string                `json:"ModuleName"`
+		Modules    []CmdModulesOutModule `json:"Modules"`
+		Unused     []CmdModulesOutModule `json:"Unused"`
+	}
+
+	// CmdModulesOutModule is go.mod requirement with list of
+	// components, that allowed to import it
+	CmdModulesOutModule struct {
+		Path       string   `json:"Path"`
+		Version    string   `json:"Version"`
+		Indirect   bool     `json:"Indirect"`
+		Components []string `json:"Components"`
+	}
+)
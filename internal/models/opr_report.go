@@ -0,0 +1,43 @@
+package models
+
+type (
+	CmdReportIn struct {
+		ProjectPath string
+		ArchFile    string
+		HTMLDir     string
+	}
+
+	CmdReportOut struct {
+		ProjectDirectory string `json:"ProjectDirectory"`
+		ModuleName       string `json:"ModuleName"`
+		OutDirectory     string `json:"OutDirectory"`
+		IndexFile        string `json:"IndexFile"`
+		ComponentsCount  int    `json:"ComponentsCount"`
+		FilesCount       int    `json:"FilesCount"`
+		NoticesCount     int    `json:"NoticesCount"`
+		WarningsCount    int    `json:"WarningsCount"`
+	}
+
+	// ReportPage is data for html report template
+	ReportPage struct {
+		ModuleName             string
+		Graph                  string
+		Notices                []CheckNotice
+		Components             []ReportComponent
+		WarningsMatch          []CheckArchWarningMatch
+		WarningsDeepScan       []CheckArchWarningDeepscan
+		WarningsDeepScanVendor []CheckArchWarningDeepscanVendor
+		WarningsModule         []CheckArchWarningModule
+		WarningsFeature        []CheckArchWarningFeature
+		WarningsVisibility     []CheckArchWarningVisibility
+		WarningsCount          int
+	}
+
+	ReportComponent struct {
+		Name         string
+		MayDependOn  []string
+		CanUse       []string
+		Files        []string
+		WarningsDeps []CheckArchWarningDependency
+	}
+)
@@ -17,8 +17,15 @@ type (
 	}
 
 	ProjectFile struct {
-		Path    string
-		Imports []ResolvedImport
+		Path       string
+		Imports    []ResolvedImport
+		Directives []FileDirective
+	}
+
+	// FileDirective is compiler directive comment in file, like "//go:embed"
+	FileDirective struct {
+		Name      string // go:embed
+		Reference common.Reference
 	}
 
 	ResolvedImport struct {
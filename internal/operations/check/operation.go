@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -53,44 +54,86 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 
 	result := models.CheckResult{}
 	if len(spec.Integrity.DocumentNotices) == 0 {
-		result, err = o.specChecker.Check(ctx, spec)
+		result, err = o.specChecker.CheckSelected(ctx, spec, models.CheckSelection{
+			Checks:    in.Checks,
+			AllChecks: in.AllChecks,
+		})
 		if err != nil {
 			return models.CmdCheckOut{}, fmt.Errorf("failed to check project deps: %w", err)
 		}
 	}
 
+	owners := newComponentOwners(spec)
+	result = o.assignOwners(result, owners)
+	if in.Owner != "" {
+		result = o.filterByOwner(result, owners, in.Owner)
+	}
+
 	limitedResult := o.limitResults(result, in.MaxWarnings)
 
 	model := models.CmdCheckOut{
-		ModuleName:             spec.ModuleName.Value,
-		DocumentNotices:        o.assembleNotice(spec.Integrity),
-		ArchHasWarnings:        o.resultsHasWarnings(limitedResult.results),
-		ArchWarningsDependency: limitedResult.results.DependencyWarnings,
-		ArchWarningsMatch:      limitedResult.results.MatchWarnings,
-		ArchWarningsDeepScan:   limitedResult.results.DeepscanWarnings,
-		OmittedCount:           limitedResult.omittedCount,
+		ModuleName:                 spec.ModuleName.Value,
+		DocumentNotices:            o.assembleNotice(spec.Integrity),
+		ArchHasWarnings:            o.resultsHasWarnings(limitedResult.results),
+		ArchWarningsDependency:     limitedResult.results.DependencyWarnings,
+		ArchWarningsMatch:          limitedResult.results.MatchWarnings,
+		ArchWarningsDeepScan:       limitedResult.results.DeepscanWarnings,
+		ArchWarningsDeepScanVendor: limitedResult.results.DeepscanVendorWarnings,
+		ArchWarningsModule:         limitedResult.results.ModuleWarnings,
+		ArchWarningsFeature:        limitedResult.results.FeatureWarnings,
+		ArchWarningsVisibility:     limitedResult.results.VisibilityWarnings,
+		OmittedCount:               limitedResult.omittedCount,
+		ShowTimings:                in.ShowTimings,
 		Qualities: []models.CheckQuality{
 			{
-				ID:   "component_imports",
-				Name: "Base: component imports",
-				Used: len(spec.Components) > 0,
-				Hint: "always on",
+				ID:      "component_imports",
+				Name:    "Base: component imports",
+				Used:    len(spec.Components) > 0,
+				Hint:    "always on",
+				Checker: models.CheckerImports,
+			},
+			{
+				ID:      "vendor_imports",
+				Name:    "Advanced: vendor imports",
+				Used:    spec.Allow.DepOnAnyVendor.Value == false,
+				Hint:    "switch 'allow.depOnAnyVendor = false' (or delete) to on",
+				Checker: models.CheckerImports,
+			},
+			{
+				ID:      "deepscan",
+				Name:    "Advanced: method calls and dependency injections",
+				Used:    spec.Allow.DeepScan.Value == true,
+				Hint:    "switch 'allow.deepScan = true' (or delete) to on",
+				Checker: models.CheckerDeepScan,
 			},
 			{
-				ID:   "vendor_imports",
-				Name: "Advanced: vendor imports",
-				Used: spec.Allow.DepOnAnyVendor.Value == false,
-				Hint: "switch 'allow.depOnAnyVendor = false' (or delete) to on",
+				ID:      "low_level_features",
+				Name:    "Advanced: low level features (cgo, unsafe, reflect, linkname, embed)",
+				Used:    spec.Allow.LowLevelFeatures.Value == false,
+				Hint:    "switch 'allow.lowLevelFeatures = false' to on",
+				Checker: models.CheckerFeatures,
 			},
 			{
-				ID:   "deepscan",
-				Name: "Advanced: method calls and dependency injections",
-				Used: spec.Allow.DeepScan.Value == true,
-				Hint: "switch 'allow.deepScan = true' (or delete) to on",
+				ID:      "private_components",
+				Name:    "Advanced: private components (imports and exported API)",
+				Used:    o.hasPrivateComponents(spec),
+				Hint:    "set 'visibility: private' in any component to on",
+				Checker: models.CheckerVisibility,
 			},
 		},
 	}
 
+	for ind, quality := range model.Qualities {
+		for _, run := range result.Runs {
+			if run.Name != quality.Checker {
+				continue
+			}
+
+			model.Qualities[ind].Ran = run.Ran
+			model.Qualities[ind].Duration = run.Duration.Round(time.Microsecond)
+		}
+	}
+
 	if model.ArchHasWarnings || len(model.DocumentNotices) > 0 {
 		// normal output with exit code 1
 		return model, models.NewUserSpaceError("check not successful")
@@ -102,9 +145,13 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) limiterResult {
 	passCount := 0
 	limitedResults := models.CheckResult{
-		DependencyWarnings: []models.CheckArchWarningDependency{},
-		MatchWarnings:      []models.CheckArchWarningMatch{},
-		DeepscanWarnings:   []models.CheckArchWarningDeepscan{},
+		DependencyWarnings:     []models.CheckArchWarningDependency{},
+		MatchWarnings:          []models.CheckArchWarningMatch{},
+		DeepscanWarnings:       []models.CheckArchWarningDeepscan{},
+		DeepscanVendorWarnings: []models.CheckArchWarningDeepscanVendor{},
+		ModuleWarnings:         []models.CheckArchWarningModule{},
+		FeatureWarnings:        []models.CheckArchWarningFeature{},
+		VisibilityWarnings:     []models.CheckArchWarningVisibility{},
 	}
 
 	// append deps
@@ -137,10 +184,54 @@ func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) lim
 		passCount++
 	}
 
+	// append deep scan vendors
+	for _, notice := range result.DeepscanVendorWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.DeepscanVendorWarnings = append(limitedResults.DeepscanVendorWarnings, notice)
+		passCount++
+	}
+
+	// append go.mod modules
+	for _, notice := range result.ModuleWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.ModuleWarnings = append(limitedResults.ModuleWarnings, notice)
+		passCount++
+	}
+
+	// append low level features
+	for _, notice := range result.FeatureWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.FeatureWarnings = append(limitedResults.FeatureWarnings, notice)
+		passCount++
+	}
+
+	// append private components visibility
+	for _, notice := range result.VisibilityWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.VisibilityWarnings = append(limitedResults.VisibilityWarnings, notice)
+		passCount++
+	}
+
 	totalCount := 0 +
 		len(result.DeepscanWarnings) +
+		len(result.DeepscanVendorWarnings) +
 		len(result.DependencyWarnings) +
-		len(result.MatchWarnings)
+		len(result.MatchWarnings) +
+		len(result.ModuleWarnings) +
+		len(result.FeatureWarnings) +
+		len(result.VisibilityWarnings)
 
 	return limiterResult{
 		results:      limitedResults,
@@ -161,6 +252,32 @@ func (o *Operation) resultsHasWarnings(result models.CheckResult) bool {
 		return true
 	}
 
+	if len(result.DeepscanVendorWarnings) > 0 {
+		return true
+	}
+
+	if len(result.ModuleWarnings) > 0 {
+		return true
+	}
+
+	if len(result.FeatureWarnings) > 0 {
+		return true
+	}
+
+	if len(result.VisibilityWarnings) > 0 {
+		return true
+	}
+
+	return false
+}
+
+func (o *Operation) hasPrivateComponents(spec arch.Spec) bool {
+	for _, component := range spec.Components {
+		if component.Private.Value {
+			return true
+		}
+	}
+
 	return false
 }
 
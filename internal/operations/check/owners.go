@@ -0,0 +1,117 @@
+package check
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+type componentOwners map[string]string // component name -> owner
+
+func newComponentOwners(spec arch.Spec) componentOwners {
+	owners := make(componentOwners)
+	for _, component := range spec.Components {
+		owners[component.Name.Value] = component.Owner.Value
+	}
+
+	return owners
+}
+
+// assignOwners fill owners of both sides in dependency warnings, and group
+// them by (component owner, imported component owner) pairs
+func (o *Operation) assignOwners(result models.CheckResult, owners componentOwners) models.CheckResult {
+	for ind, warning := range result.DependencyWarnings {
+		result.DependencyWarnings[ind].ComponentOwner = owners[warning.ComponentName]
+		result.DependencyWarnings[ind].ImportedComponentOwner = owners[warning.ImportedComponentName]
+	}
+
+	sort.SliceStable(result.DependencyWarnings, func(i, j int) bool {
+		a, b := result.DependencyWarnings[i], result.DependencyWarnings[j]
+		if a.ComponentOwner != b.ComponentOwner {
+			return a.ComponentOwner < b.ComponentOwner
+		}
+
+		return a.ImportedComponentOwner < b.ImportedComponentOwner
+	})
+
+	return result
+}
+
+// filterByOwner keep only warnings, where at least one of related components
+// is owned by owner. Warnings without components (not matched files,
+// go.mod modules) is always skipped
+func (o *Operation) filterByOwner(result models.CheckResult, owners componentOwners, owner string) models.CheckResult {
+	owned := func(componentNames ...string) bool {
+		for _, componentName := range componentNames {
+			if ownerMatch(owners[componentName], owner) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	filtered := models.CheckResult{
+		DependencyWarnings:     []models.CheckArchWarningDependency{},
+		MatchWarnings:          []models.CheckArchWarningMatch{},
+		DeepscanWarnings:       []models.CheckArchWarningDeepscan{},
+		DeepscanVendorWarnings: []models.CheckArchWarningDeepscanVendor{},
+		ModuleWarnings:         []models.CheckArchWarningModule{},
+		FeatureWarnings:        []models.CheckArchWarningFeature{},
+		VisibilityWarnings:     []models.CheckArchWarningVisibility{},
+		Runs:                   result.Runs,
+	}
+
+	for _, warning := range result.DependencyWarnings {
+		if owned(warning.ComponentName, warning.ImportedComponentName) {
+			filtered.DependencyWarnings = append(filtered.DependencyWarnings, warning)
+		}
+	}
+
+	for _, warning := range result.DeepscanWarnings {
+		if owned(warning.Gate.ComponentName, warning.Dependency.ComponentName) {
+			filtered.DeepscanWarnings = append(filtered.DeepscanWarnings, warning)
+		}
+	}
+
+	for _, warning := range result.DeepscanVendorWarnings {
+		if owned(warning.Gate.ComponentName) {
+			filtered.DeepscanVendorWarnings = append(filtered.DeepscanVendorWarnings, warning)
+		}
+	}
+
+	for _, warning := range result.FeatureWarnings {
+		if owned(warning.ComponentName) {
+			filtered.FeatureWarnings = append(filtered.FeatureWarnings, warning)
+		}
+	}
+
+	for _, warning := range result.VisibilityWarnings {
+		if owned(warning.ComponentName, warning.PrivateComponentName) {
+			filtered.VisibilityWarnings = append(filtered.VisibilityWarnings, warning)
+		}
+	}
+
+	return filtered
+}
+
+// ownerMatch compare component owner with filter, leading '@' is optional
+// and team may be passed without organization:
+//
+//	@org/team-payments == org/team-payments == team-payments
+func ownerMatch(owner string, filter string) bool {
+	owner = strings.TrimPrefix(owner, "@")
+	filter = strings.TrimPrefix(filter, "@")
+
+	if owner == "" || filter == "" {
+		return false
+	}
+
+	if strings.EqualFold(owner, filter) {
+		return true
+	}
+
+	return strings.HasSuffix(strings.ToLower(owner), "/"+strings.ToLower(filter))
+}
@@ -0,0 +1,17 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ownerMatch(t *testing.T) {
+	assert.True(t, ownerMatch("@acme/team-payments", "team-payments"))
+	assert.True(t, ownerMatch("@acme/team-payments", "@acme/team-payments"))
+	assert.True(t, ownerMatch("@acme/team-payments", "acme/Team-Payments"))
+	assert.True(t, ownerMatch("@jdoe", "jdoe"))
+	assert.False(t, ownerMatch("@acme/team-payments", "payments"))
+	assert.False(t, ownerMatch("", "team-payments"))
+	assert.False(t, ownerMatch("@acme/team-payments", ""))
+}
@@ -22,6 +22,6 @@ type (
 	}
 
 	specChecker interface {
-		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+		CheckSelected(ctx context.Context, spec arch.Spec, selection models.CheckSelection) (models.CheckResult, error)
 	}
 )
@@ -0,0 +1,206 @@
+package deepscanInventory
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+var csvHeader = []string{
+	"component",
+	"gate",
+	"param",
+	"interface",
+	"interface_import",
+	"gate_definition",
+	"implementation_component",
+	"implementation_import",
+	"implementation_is_vendor",
+	"implementation",
+	"injection",
+	"injection_place",
+}
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specAssembler        specAssembler
+	gatesInventory       gatesInventory
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specAssembler specAssembler,
+	gatesInventory gatesInventory,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specAssembler:        specAssembler,
+		gatesInventory:       gatesInventory,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdDeepscanInventoryIn) (models.CmdDeepscanInventoryOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdDeepscanInventoryOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdDeepscanInventoryOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return models.CmdDeepscanInventoryOut{}, fmt.Errorf("arch file has %d notices, run 'check' command for details",
+			len(spec.Integrity.DocumentNotices),
+		)
+	}
+
+	gates, err := o.gatesInventory.Inventory(ctx, spec)
+	if err != nil {
+		return models.CmdDeepscanInventoryOut{}, fmt.Errorf("failed to find deepscan gates: %w", err)
+	}
+
+	out := models.CmdDeepscanInventoryOut{
+		ModuleName:           spec.ModuleName.Value,
+		Gates:                gates,
+		Interfaces:           o.assembleInterfaces(gates),
+		NotInjectedGates:     []models.DeepscanInventoryGate{},
+		SingleImplementation: []models.DeepscanInventoryInterface{},
+	}
+
+	for _, gate := range gates {
+		out.ImplementationsCount += len(gate.Implementations)
+
+		if len(gate.Implementations) == 0 {
+			out.NotInjectedGates = append(out.NotInjectedGates, gate)
+		}
+	}
+
+	for _, iface := range out.Interfaces {
+		if len(iface.Implementations) == 1 {
+			out.SingleImplementation = append(out.SingleImplementation, iface)
+		}
+	}
+
+	if in.CSVFile != "" {
+		out.CSVFile = in.CSVFile
+
+		err = o.writeCSV(out.CSVFile, gates)
+		if err != nil {
+			return models.CmdDeepscanInventoryOut{}, fmt.Errorf("failed to export csv: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// assembleInterfaces group gates by used interface, and collect all
+// unique types, injected into this interface
+func (o *Operation) assembleInterfaces(gates []models.DeepscanInventoryGate) []models.DeepscanInventoryInterface {
+	type key struct {
+		name       string
+		importPath string
+	}
+
+	interfaces := make(map[key]*models.DeepscanInventoryInterface)
+	known := make(map[key]map[string]struct{})
+
+	for _, gate := range gates {
+		k := key{name: gate.Interface, importPath: gate.InterfaceImport}
+		if _, exist := interfaces[k]; !exist {
+			interfaces[k] = &models.DeepscanInventoryInterface{
+				Name:            gate.Interface,
+				Import:          gate.InterfaceImport,
+				Implementations: []string{},
+			}
+			known[k] = map[string]struct{}{}
+		}
+
+		iface := interfaces[k]
+		iface.GatesCount++
+
+		for _, implementation := range gate.Implementations {
+			if _, exist := known[k][implementation.Name]; exist {
+				continue
+			}
+
+			known[k][implementation.Name] = struct{}{}
+			iface.Implementations = append(iface.Implementations, implementation.Name)
+		}
+	}
+
+	results := make([]models.DeepscanInventoryInterface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		sort.Strings(iface.Implementations)
+		results = append(results, *iface)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Name != results[j].Name {
+			return results[i].Name < results[j].Name
+		}
+
+		return results[i].Import < results[j].Import
+	})
+
+	return results
+}
+
+// writeCSV export inventory as flat table, one row for every
+// implementation. Gates without injections are exported
+// as single row with empty implementation columns
+func (o *Operation) writeCSV(fileName string, gates []models.DeepscanInventoryGate) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("failed create file '%s': %w", fileName, err)
+	}
+
+	writer := csv.NewWriter(file)
+	rows := [][]string{csvHeader}
+
+	for _, gate := range gates {
+		gateColumns := []string{
+			gate.ComponentName,
+			gate.Name,
+			gate.ParamName,
+			gate.Interface,
+			gate.InterfaceImport,
+			gate.RelativePath,
+		}
+
+		if len(gate.Implementations) == 0 {
+			rows = append(rows, append(gateColumns, "", "", "", "", "", ""))
+			continue
+		}
+
+		for _, implementation := range gate.Implementations {
+			rows = append(rows, append(append([]string{}, gateColumns...),
+				implementation.ComponentName,
+				implementation.Import,
+				strconv.FormatBool(implementation.IsVendor),
+				implementation.Name,
+				implementation.InjectionAST,
+				implementation.InjectionPath,
+			))
+		}
+	}
+
+	err = writer.WriteAll(rows)
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed write csv into '%s': %w", fileName, err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("failed close file '%s': %w", fileName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package deepscanInventory
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	gatesInventory interface {
+		Inventory(ctx context.Context, spec arch.Spec) ([]models.DeepscanInventoryGate, error)
+	}
+)
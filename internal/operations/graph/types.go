@@ -1,6 +1,9 @@
 package graph
 
 import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
@@ -13,4 +16,10 @@ type (
 	projectInfoAssembler interface {
 		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
 	}
+
+	graphRenderer interface {
+		ValidateTheme(name string) error
+		Definitions(spec arch.Spec, opts models.CmdGraphIn) (d2Code []byte, definitions []byte, err error)
+		Compile(ctx context.Context, d2Code []byte, opts models.CmdGraphIn) ([]byte, error)
+	}
 )
@@ -47,7 +47,7 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdMappingIn) (models.
 		ProjectDirectory: spec.RootDirectory.Value,
 		ModuleName:       spec.ModuleName.Value,
 		MappingGrouped:   assembleMappingByComponent(spec, projectFiles),
-		MappingList:      assembleMappingByFile(projectFiles),
+		MappingList:      assembleMappingByFile(spec, projectFiles),
 		Scheme:           in.Scheme,
 	}, nil
 }
@@ -77,16 +77,23 @@ func assembleMappingByComponent(
 	mapping := make([]models.CmdMappingOutGrouped, 0)
 	for _, component := range spec.Components {
 		componentName := component.Name.Value
-		if grouped, exist := tmp[componentName]; exist {
-			sort.Strings(grouped.FileNames)
-			mapping = append(mapping, *grouped)
-			continue
-		}
-
-		mapping = append(mapping, models.CmdMappingOutGrouped{
+		grouped := models.CmdMappingOutGrouped{
 			ComponentName: componentName,
 			FileNames:     []string{},
-		})
+		}
+
+		if files, exist := tmp[componentName]; exist {
+			sort.Strings(files.FileNames)
+			grouped.FileNames = files.FileNames
+		}
+
+		grouped.Owner = component.Owner.Value
+		grouped.Description = component.Description.Value
+		for _, tag := range component.Tags {
+			grouped.Tags = append(grouped.Tags, tag.Value)
+		}
+
+		mapping = append(mapping, grouped)
 	}
 
 	emptyComponentID := componentName(nil)
@@ -109,10 +116,15 @@ func assembleMappingByComponent(
 	return mapping
 }
 
-func assembleMappingByFile(projectFiles []models.FileHold) []models.CmdMappingOutList {
+func assembleMappingByFile(spec arch.Spec, projectFiles []models.FileHold) []models.CmdMappingOutList {
 	mapping := make([]models.CmdMappingOutList, 0)
 	exist := make(map[string]struct{})
 
+	owners := make(map[string]string)
+	for _, component := range spec.Components {
+		owners[component.Name.Value] = component.Owner.Value
+	}
+
 	for _, projectFile := range projectFiles {
 		fileName := projectFile.File.Path
 
@@ -123,6 +135,7 @@ func assembleMappingByFile(projectFiles []models.FileHold) []models.CmdMappingOu
 		mapping = append(mapping, models.CmdMappingOutList{
 			FileName:      fileName,
 			ComponentName: componentName(projectFile.ComponentID),
+			Owner:         owners[componentName(projectFile.ComponentID)],
 		})
 
 		exist[fileName] = struct{}{}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specAssembler        specAssembler
+	metricsCalculator    metricsCalculator
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specAssembler specAssembler,
+	metricsCalculator metricsCalculator,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specAssembler:        specAssembler,
+		metricsCalculator:    metricsCalculator,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdMetricsIn) (models.CmdMetricsOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdMetricsOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdMetricsOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return models.CmdMetricsOut{}, fmt.Errorf("arch file has %d notices, run 'check' command for details",
+			len(spec.Integrity.DocumentNotices),
+		)
+	}
+
+	components, err := o.metricsCalculator.Calculate(ctx, spec)
+	if err != nil {
+		return models.CmdMetricsOut{}, fmt.Errorf("failed to calculate metrics: %w", err)
+	}
+
+	model := models.CmdMetricsOut{
+		ModuleName:  spec.ModuleName.Value,
+		MaxDistance: in.MaxDistance,
+		Components:  components,
+	}
+
+	for ind := range model.Components {
+		if model.Components[ind].Distance <= in.MaxDistance {
+			continue
+		}
+
+		model.Components[ind].ExceedsThreshold = true
+		model.ThresholdExceeded = true
+	}
+
+	if model.ThresholdExceeded {
+		// normal output with exit code 1
+		return model, models.NewUserSpaceError("metrics threshold exceeded")
+	}
+
+	return model, nil
+}
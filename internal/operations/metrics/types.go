@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	metricsCalculator interface {
+		Calculate(ctx context.Context, spec arch.Spec) ([]models.ComponentMetrics, error)
+	}
+)
@@ -0,0 +1,83 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specMigrator         specMigrator
+	differ               differ
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specMigrator specMigrator,
+	differ differ,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specMigrator:         specMigrator,
+		differ:               differ,
+	}
+}
+
+func (o *Operation) Behave(_ context.Context, in models.CmdMigrateIn) (models.CmdMigrateOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	archFile := projectInfo.GoArchFilePath
+	switch strings.ToLower(filepath.Ext(archFile)) {
+	case ".yml", ".yaml":
+	default:
+		return models.CmdMigrateOut{}, fmt.Errorf("migration is supported only for yaml arch files, got '%s'", filepath.Base(archFile))
+	}
+
+	stat, err := os.Stat(archFile)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to stat arch file '%s': %w", archFile, err)
+	}
+
+	sourceCode, err := os.ReadFile(archFile)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to read arch file '%s': %w", archFile, err)
+	}
+
+	migration, err := o.specMigrator.Migrate(sourceCode, in.ToVersion)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to migrate arch file: %w", err)
+	}
+
+	// diff header use paths relative to project, same as git
+	fileName := filepath.ToSlash(strings.TrimPrefix(archFile, projectInfo.Directory+string(filepath.Separator)))
+
+	out := models.CmdMigrateOut{
+		ArchFile:    archFile,
+		FromVersion: migration.FromVersion,
+		ToVersion:   migration.ToVersion,
+		Changes:     migration.Changes,
+		Diff:        o.differ.Unified("a/"+fileName, "b/"+fileName, sourceCode, migration.SourceCode),
+		DryRun:      in.DryRun,
+		Written:     false,
+	}
+
+	if in.DryRun || out.Diff == "" {
+		return out, nil
+	}
+
+	err = os.WriteFile(archFile, migration.SourceCode, stat.Mode())
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to write arch file '%s': %w", archFile, err)
+	}
+
+	out.Written = true
+	return out, nil
+}
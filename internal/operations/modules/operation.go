@@ -0,0 +1,94 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specAssembler        specAssembler
+	moduleChecker        moduleChecker
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specAssembler specAssembler,
+	moduleChecker moduleChecker,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specAssembler:        specAssembler,
+		moduleChecker:        moduleChecker,
+	}
+}
+
+func (o *Operation) Behave(_ context.Context, in models.CmdModulesIn) (models.CmdModulesOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdModulesOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdModulesOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		return models.CmdModulesOut{}, fmt.Errorf("spec has %d notices, run 'check' command for details",
+			len(spec.Integrity.DocumentNotices),
+		)
+	}
+
+	out := models.CmdModulesOut{
+		ModuleName: spec.ModuleName.Value,
+		Modules:    make([]models.CmdModulesOutModule, 0, len(spec.GoModules)),
+		Unused:     make([]models.CmdModulesOutModule, 0),
+	}
+
+	for _, goModule := range spec.GoModules {
+		components, err := o.allowedComponents(spec, goModule)
+		if err != nil {
+			return models.CmdModulesOut{}, fmt.Errorf("failed check module '%s': %w", goModule.Path, err)
+		}
+
+		module := models.CmdModulesOutModule{
+			Path:       goModule.Path,
+			Version:    goModule.Version,
+			Indirect:   goModule.Indirect,
+			Components: components,
+		}
+
+		out.Modules = append(out.Modules, module)
+
+		// indirect modules is not imported by project code
+		if len(components) == 0 && !goModule.Indirect {
+			out.Unused = append(out.Unused, module)
+		}
+	}
+
+	return out, nil
+}
+
+func (o *Operation) allowedComponents(spec arch.Spec, goModule common.GoModule) ([]string, error) {
+	components := make([]string, 0)
+
+	for _, component := range spec.Components {
+		allowed, err := o.moduleChecker.ModuleAllowed(spec, component, goModule)
+		if err != nil {
+			return nil, fmt.Errorf("failed check component '%s': %w", component.Name.Value, err)
+		}
+
+		if allowed {
+			components = append(components, component.Name.Value)
+		}
+	}
+
+	sort.Strings(components)
+	return components, nil
+}
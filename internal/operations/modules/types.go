@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	moduleChecker interface {
+		ModuleAllowed(spec arch.Spec, component arch.Component, goModule common.GoModule) (bool, error)
+	}
+)
@@ -0,0 +1,308 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+const (
+	reportIndexFile = "index.html"
+	reportGraphFile = "graph.svg"
+
+	// componentAnchor is used as html id of component section,
+	// graph nodes and warnings link to it
+	componentAnchor = "component-%s"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specAssembler        specAssembler
+	specChecker          specChecker
+	projectFilesResolver projectFilesResolver
+	referenceRender      referenceRender
+	graphRenderer        graphRenderer
+	pageTemplate         string
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specAssembler specAssembler,
+	specChecker specChecker,
+	projectFilesResolver projectFilesResolver,
+	referenceRender referenceRender,
+	graphRenderer graphRenderer,
+	pageTemplate string,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specAssembler:        specAssembler,
+		specChecker:          specChecker,
+		projectFilesResolver: projectFilesResolver,
+		referenceRender:      referenceRender,
+		graphRenderer:        graphRenderer,
+		pageTemplate:         pageTemplate,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdReportIn) (models.CmdReportOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := o.specAssembler.Assemble(projectInfo)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	result := models.CheckResult{}
+	if len(spec.Integrity.DocumentNotices) == 0 {
+		result, err = o.specChecker.Check(ctx, spec)
+		if err != nil {
+			return models.CmdReportOut{}, fmt.Errorf("failed to check project deps: %w", err)
+		}
+	}
+
+	projectFiles, err := o.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	outDir, err := filepath.Abs(in.HTMLDir)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed get abs path from '%s': %w", in.HTMLDir, err)
+	}
+
+	page := models.ReportPage{
+		ModuleName:             spec.ModuleName.Value,
+		Notices:                o.assembleNotices(spec.Integrity),
+		Components:             o.assembleComponents(spec, projectFiles, result.DependencyWarnings),
+		WarningsMatch:          result.MatchWarnings,
+		WarningsDeepScan:       result.DeepscanWarnings,
+		WarningsDeepScanVendor: result.DeepscanVendorWarnings,
+		WarningsModule:         result.ModuleWarnings,
+		WarningsFeature:        result.FeatureWarnings,
+		WarningsVisibility:     result.VisibilityWarnings,
+		WarningsCount: 0 +
+			len(result.DependencyWarnings) +
+			len(result.MatchWarnings) +
+			len(result.DeepscanWarnings) +
+			len(result.DeepscanVendorWarnings) +
+			len(result.ModuleWarnings) +
+			len(result.FeatureWarnings) +
+			len(result.VisibilityWarnings),
+	}
+
+	out := models.CmdReportOut{
+		ProjectDirectory: spec.RootDirectory.Value,
+		ModuleName:       spec.ModuleName.Value,
+		OutDirectory:     outDir,
+		IndexFile:        filepath.Join(outDir, reportIndexFile),
+		ComponentsCount:  len(page.Components),
+		FilesCount:       o.countFiles(page.Components),
+		NoticesCount:     len(page.Notices),
+		WarningsCount:    page.WarningsCount,
+	}
+
+	err = os.MkdirAll(outDir, os.ModePerm)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed create report directory '%s': %w", in.HTMLDir, err)
+	}
+
+	if len(spec.Components) > 0 {
+		page.Graph, err = o.renderGraph(ctx, spec, outDir)
+		if err != nil {
+			return models.CmdReportOut{}, fmt.Errorf("failed to render graph: %w", err)
+		}
+	}
+
+	content, err := o.renderPage(page)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed to render report page: %w", err)
+	}
+
+	err = os.WriteFile(out.IndexFile, content, os.ModePerm)
+	if err != nil {
+		return models.CmdReportOut{}, fmt.Errorf("failed write report into '%s' file: %w", out.IndexFile, err)
+	}
+
+	return out, nil
+}
+
+// renderGraph will write svg graph into report directory and return
+// its source, for embedding into page (links from nodes work only in inline svg)
+func (o *Operation) renderGraph(ctx context.Context, spec arch.Spec, outDir string) (string, error) {
+	graphFile := filepath.Join(outDir, reportGraphFile)
+	opts := models.CmdGraphIn{
+		Type:     models.GraphTypeFlow,
+		Format:   models.GraphFormatSVG,
+		Focus:    []string{},
+		Groups:   []string{},
+		Theme:    models.DefaultGraphTheme,
+		Sketch:   false,
+		Layout:   models.GraphLayoutDagre,
+		Padding:  models.DefaultGraphPadding,
+		NodeLink: "#" + componentAnchor,
+	}
+
+	d2Code, _, err := o.graphRenderer.Definitions(spec, opts)
+	if err != nil {
+		return "", err
+	}
+
+	svg, err := o.graphRenderer.Compile(ctx, d2Code, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to compile graph: %w", err)
+	}
+
+	err = os.WriteFile(graphFile, svg, os.ModePerm)
+	if err != nil {
+		return "", fmt.Errorf("failed write graph into '%s' file: %w", graphFile, err)
+	}
+
+	// xml declaration is not allowed inside html
+	source := string(svg)
+	if strings.HasPrefix(source, "<?xml") {
+		source = source[strings.Index(source, "?>")+2:]
+	}
+
+	return source, nil
+}
+
+func (o *Operation) renderPage(page models.ReportPage) ([]byte, error) {
+	tpl, err := template.New("report").Funcs(template.FuncMap{
+		"anchor": func(componentName string) string {
+			return fmt.Sprintf(componentAnchor, componentName)
+		},
+		"unsafe": func(source string) template.HTML {
+			// graph svg is generated by us
+			return template.HTML(source)
+		},
+	}).Parse(o.pageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed parse report template: %w", err)
+	}
+
+	var buff bytes.Buffer
+	err = tpl.Execute(&buff, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed execute report template: %w", err)
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (o *Operation) assembleComponents(
+	spec arch.Spec,
+	projectFiles []models.FileHold,
+	warnings []models.CheckArchWarningDependency,
+) []models.ReportComponent {
+	rootPrefix := spec.RootDirectory.Value + string(filepath.Separator)
+	components := make([]models.ReportComponent, 0, len(spec.Components))
+
+	for _, cmp := range spec.Components {
+		component := models.ReportComponent{
+			Name:         cmp.Name.Value,
+			MayDependOn:  []string{},
+			CanUse:       []string{},
+			Files:        []string{},
+			WarningsDeps: []models.CheckArchWarningDependency{},
+		}
+
+		for _, dep := range cmp.MayDependOn {
+			component.MayDependOn = append(component.MayDependOn, dep.Value)
+		}
+
+		for _, vnd := range cmp.CanUse {
+			component.CanUse = append(component.CanUse, vnd.Value)
+		}
+
+		exist := make(map[string]struct{})
+		for _, projectFile := range projectFiles {
+			if projectFile.ComponentID == nil || *projectFile.ComponentID != cmp.Name.Value {
+				continue
+			}
+
+			fileName := strings.TrimPrefix(projectFile.File.Path, rootPrefix)
+			if _, exist := exist[fileName]; exist {
+				continue
+			}
+
+			component.Files = append(component.Files, fileName)
+			exist[fileName] = struct{}{}
+		}
+
+		for _, warning := range warnings {
+			if warning.ComponentName != cmp.Name.Value {
+				continue
+			}
+
+			warning.SourceCodePreview = o.referenceRender.SourceCode(
+				warning.Reference.ExtendRange(1, 1),
+				false,
+				true,
+			)
+
+			component.WarningsDeps = append(component.WarningsDeps, warning)
+		}
+
+		sort.Strings(component.Files)
+		components = append(components, component)
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	return components
+}
+
+func (o *Operation) assembleNotices(integrity arch.Integrity) []models.CheckNotice {
+	results := make([]models.CheckNotice, 0, len(integrity.DocumentNotices))
+
+	for _, notice := range integrity.DocumentNotices {
+		results = append(results, models.CheckNotice{
+			Text:   fmt.Sprintf("%s", notice.Notice),
+			File:   notice.Ref.File,
+			Line:   notice.Ref.Line,
+			Column: notice.Ref.Column,
+			SourceCodePreview: o.referenceRender.SourceCode(
+				notice.Ref.ExtendRange(1, 1),
+				false,
+				true,
+			),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		sI := results[i]
+		sJ := results[j]
+
+		if sI.File == sJ.File {
+			return sI.Line < sJ.Line
+		}
+
+		return sI.File < sJ.File
+	})
+
+	return results
+}
+
+func (o *Operation) countFiles(components []models.ReportComponent) int {
+	count := 0
+
+	for _, component := range components {
+		count += len(component.Files)
+	}
+
+	return count
+}
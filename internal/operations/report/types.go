@@ -0,0 +1,36 @@
+package report
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specAssembler interface {
+		Assemble(prj common.Project) (arch.Spec, error)
+	}
+
+	specChecker interface {
+		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+	}
+
+	projectFilesResolver interface {
+		ProjectFiles(ctx context.Context, spec arch.Spec) ([]models.FileHold, error)
+	}
+
+	referenceRender interface {
+		SourceCode(ref common.Reference, highlight bool, showPointer bool) []byte
+	}
+
+	graphRenderer interface {
+		Definitions(spec arch.Spec, opts models.CmdGraphIn) (d2Code []byte, definitions []byte, err error)
+		Compile(ctx context.Context, d2Code []byte, opts models.CmdGraphIn) ([]byte, error)
+	}
+)
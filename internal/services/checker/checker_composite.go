@@ -3,6 +3,7 @@ package checker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -16,21 +17,62 @@ func NewCompositeChecker(checkers ...checker) *CompositeChecker {
 	return &CompositeChecker{checkers: checkers}
 }
 
+// Check will run all checkers in order, and stop on first
+// checker with notices (unless spec 'allow.allChecks' is on)
 func (c *CompositeChecker) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	return c.CheckSelected(ctx, spec, models.CheckSelection{})
+}
+
+// CheckSelected is same as Check, but will run only selected checkers
+func (c *CompositeChecker) CheckSelected(
+	ctx context.Context,
+	spec arch.Spec,
+	selection models.CheckSelection,
+) (models.CheckResult, error) {
 	overallResults := models.CheckResult{}
+	failFast := !selection.AllChecks && !spec.Allow.AllChecks.Value
+	stopped := false
+
+	for _, checker := range c.checkers {
+		run := models.CheckerRun{
+			Name: checker.Name(),
+		}
 
-	for ind, checker := range c.checkers {
+		if stopped || !c.isSelected(checker.Name(), selection.Checks) {
+			overallResults.Runs = append(overallResults.Runs, run)
+			continue
+		}
+
+		startedAt := time.Now()
 		results, err := checker.Check(ctx, spec)
 		if err != nil {
 			return models.CheckResult{}, fmt.Errorf("checker failed '%T': %w", checker, err)
 		}
 
+		run.Ran = true
+		run.Duration = time.Since(startedAt)
+
 		overallResults.Append(results)
+		overallResults.Runs = append(overallResults.Runs, run)
 
-		if results.HasNotices() && ind < len(c.checkers)-1 {
-			break
+		if results.HasNotices() && failFast {
+			stopped = true
 		}
 	}
 
 	return overallResults, nil
 }
+
+func (c *CompositeChecker) isSelected(name string, selected []string) bool {
+	if len(selected) == 0 {
+		return true
+	}
+
+	for _, selectedName := range selected {
+		if selectedName == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,9 +3,11 @@ package checker
 import (
 	"context"
 	"fmt"
+	"go/token"
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -68,36 +70,19 @@ func (c *DeepScan) workersCount() int {
 	// return half
 }
 
+func (c *DeepScan) Name() string {
+	return models.CheckerDeepScan
+}
+
 func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
 	maxWorkers := c.workersCount()
 
 	c.Mutex.Lock()
 	defer c.Mutex.Unlock()
 
-	// -- prepare shared objects
-	c.spec = spec
-	c.result = models.CheckResult{}
-
-	// -- prepare mapping file -> component
-	mapping, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	err := c.prepare(ctx, spec)
 	if err != nil {
-		return models.CheckResult{}, fmt.Errorf("failed resolve project files: %w", err)
-	}
-
-	c.fileComponents = map[string]string{}
-	c.packageComponents = map[string]string{}
-
-	for _, hold := range mapping {
-		if hold.ComponentID == nil {
-			continue
-		}
-
-		// cache file -> component ref
-		c.fileComponents[hold.File.Path] = *hold.ComponentID
-
-		// cache package -> component ref
-		packagePath := filepath.Dir(hold.File.Path)
-		c.packageComponents[packagePath] = *hold.ComponentID
+		return models.CheckResult{}, err
 	}
 
 	// -- scan project
@@ -137,7 +122,134 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 	return c.result, nil
 }
 
-func (c *DeepScan) checkComponent(ctx context.Context, cmp arch.Component) error {
+// Inventory return all gates found in project components, with
+// all injected implementations, independent of spec rules
+func (c *DeepScan) Inventory(ctx context.Context, spec arch.Spec) ([]models.DeepscanInventoryGate, error) {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	err := c.prepare(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	gates := make([]models.DeepscanInventoryGate, 0)
+
+	for _, cmp := range spec.Components {
+		for _, absPath := range c.componentPackages(cmp) {
+			usages, err := c.findUsages(ctx, absPath)
+			if err != nil {
+				return nil, fmt.Errorf("failed scan '%s': %w", absPath, err)
+			}
+
+			for _, usage := range usages {
+				for _, gate := range usage.Gates {
+					gates = append(gates, c.inventoryGate(cmp.Name.Value, &gate))
+				}
+			}
+		}
+	}
+
+	sort.Slice(gates, func(i, j int) bool {
+		if gates[i].ComponentName != gates[j].ComponentName {
+			return gates[i].ComponentName < gates[j].ComponentName
+		}
+
+		if gates[i].Definition.File != gates[j].Definition.File {
+			return gates[i].Definition.File < gates[j].Definition.File
+		}
+
+		return gates[i].Definition.Line < gates[j].Definition.Line
+	})
+
+	return gates, nil
+}
+
+func (c *DeepScan) inventoryGate(componentName string, gate *deepscan.Gate) models.DeepscanInventoryGate {
+	result := models.DeepscanInventoryGate{
+		ComponentName:   componentName,
+		Name:            c.gateName(gate),
+		ParamName:       gate.ParamName,
+		Interface:       c.interfaceName(gate),
+		InterfaceImport: "",
+		Definition:      gate.ArgumentDefinition.Place,
+		RelativePath:    c.definitionToRelPath(gate.ArgumentDefinition.Place),
+		Implementations: make([]models.DeepscanInventoryImplementation, 0, len(gate.Implementations)),
+	}
+
+	if token.IsIdentifier(gate.Interface.Name) && c.isProjectFile(gate.Interface.Definition.Place.File) {
+		result.InterfaceImport = gate.Interface.Definition.Import
+	}
+
+	for _, imp := range gate.Implementations {
+		targetImport := imp.Target.Definition.Import
+		targetComponentID := c.fileComponents[imp.Target.Definition.Place.File]
+
+		result.Implementations = append(result.Implementations, models.DeepscanInventoryImplementation{
+			ComponentName: targetComponentID,
+			Import:        targetImport,
+			IsVendor:      !c.isProjectImport(targetImport),
+			Name: fmt.Sprintf("%s.%s",
+				imp.Target.Definition.Pkg,
+				imp.Target.StructName,
+			),
+			InjectionAST:  imp.Injector.CodeName,
+			Injection:     imp.Injector.ParamDefinition.Place,
+			InjectionPath: c.definitionToRelPath(imp.Injector.ParamDefinition.Place),
+		})
+	}
+
+	return result
+}
+
+// interfaceName is interface name with package (example: `io.Writer`),
+// anonymous interfaces and function signatures are returned as is
+func (c *DeepScan) interfaceName(gate *deepscan.Gate) string {
+	if !token.IsIdentifier(gate.Interface.Name) {
+		return gate.Interface.Name
+	}
+
+	return fmt.Sprintf("%s.%s", gate.Interface.Definition.Pkg, gate.Interface.Name)
+}
+
+func (c *DeepScan) isProjectFile(filePath string) bool {
+	return strings.HasPrefix(filePath, c.spec.RootDirectory.Value+string(filepath.Separator))
+}
+
+func (c *DeepScan) prepare(ctx context.Context, spec arch.Spec) error {
+	// -- prepare shared objects
+	c.spec = spec
+	c.result = models.CheckResult{}
+
+	// -- prepare mapping file -> component
+	mapping, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return fmt.Errorf("failed resolve project files: %w", err)
+	}
+
+	c.fileComponents = map[string]string{}
+	c.packageComponents = map[string]string{}
+
+	for _, hold := range mapping {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		// cache file -> component ref
+		c.fileComponents[hold.File.Path] = *hold.ComponentID
+
+		// cache package -> component ref
+		packagePath := filepath.Dir(hold.File.Path)
+		c.packageComponents[packagePath] = *hold.ComponentID
+	}
+
+	return nil
+}
+
+// componentPackages return abs paths of all packages, owned by component
+func (c *DeepScan) componentPackages(cmp arch.Component) []string {
+	packages := make([]string, 0, len(cmp.ResolvedPaths))
+
 	for _, packagePath := range cmp.ResolvedPaths {
 		absPath := packagePath.Value.AbsPath
 		matchedCmp, ok := c.packageComponents[absPath]
@@ -155,6 +267,14 @@ func (c *DeepScan) checkComponent(ctx context.Context, cmp arch.Component) error
 			continue
 		}
 
+		packages = append(packages, absPath)
+	}
+
+	return packages
+}
+
+func (c *DeepScan) checkComponent(ctx context.Context, cmp arch.Component) error {
+	for _, absPath := range c.componentPackages(cmp) {
 		err := c.scanPackage(ctx, &cmp, absPath)
 		if err != nil {
 			return fmt.Errorf("failed scan '%s': %w", absPath, err)
@@ -239,20 +359,26 @@ func (c *DeepScan) checkImplementation(
 	gatePath := gate.MethodDefinition.Place.File
 	gateComponentID, gateDefined := c.fileComponents[gatePath]
 
-	if !targetDefined || !gateDefined {
-		// target component is vendor or std file, not described in mapping
-		// we can check vendor libs too, but this requires another scan process
-
-		// example of skipping target:
-		// - $GOROOT/src/context/context.go (stdlib)
-		// - /home/neo/go/src/example.com/ns/awesome/vendor/libs.example.com/good/producer/client.go (vendor)
+	if !gateDefined {
 		return nil
 	}
 
+	if !targetDefined {
+		if c.isProjectImport(injectedImport) {
+			// project file, not described in mapping (excluded, etc..)
+			return nil
+		}
+
+		// target is vendor or std file, example:
+		// - $GOROOT/src/database/sql/sql.go (stdlib)
+		// - /home/neo/go/pkg/mod/github.com/redis/go-redis/v9/redis.go (vendor)
+		return c.checkVendorImplementation(cmp, gate, gateComponentID, imp)
+	}
+
 	warn := models.CheckArchWarningDeepscan{
 		Gate: models.DeepscanWarningGate{
 			ComponentName: gateComponentID,
-			MethodName:    gate.MethodName,
+			MethodName:    c.gateName(gate),
 			RelativePath:  c.definitionToRelPath(gate.ArgumentDefinition.Place),
 			Definition:    gate.ArgumentDefinition.Place,
 		},
@@ -281,6 +407,93 @@ func (c *DeepScan) checkImplementation(
 	return nil
 }
 
+// checkVendorImplementation validate injected vendor (or std) type with same
+// rules, as imports checker: vendors against component canUse list, and
+// std packages against cannotUseStd list (std is allowed by default)
+func (c *DeepScan) checkVendorImplementation(
+	cmp *arch.Component,
+	gate *deepscan.Gate,
+	gateComponentID string,
+	imp *deepscan.Implementation,
+) error {
+	injectedImport := imp.Target.Definition.Import
+
+	importType := models.ImportTypeVendor
+	if isStdImportPath(injectedImport) {
+		importType = models.ImportTypeStdLib
+	}
+
+	allowed, err := checkImport(*cmp, models.ResolvedImport{
+		Name:       injectedImport,
+		ImportType: importType,
+		Reference:  imp.Target.Definition.Place,
+	}, c.spec.Allow.DepOnAnyVendor.Value, c.spec.GoModules)
+	if err != nil {
+		return fmt.Errorf("failed check vendor '%s': %w", injectedImport, err)
+	}
+
+	if allowed {
+		return nil
+	}
+
+	warn := models.CheckArchWarningDeepscanVendor{
+		Gate: models.DeepscanWarningGate{
+			ComponentName: gateComponentID,
+			MethodName:    c.gateName(gate),
+			RelativePath:  c.definitionToRelPath(gate.ArgumentDefinition.Place),
+			Definition:    gate.ArgumentDefinition.Place,
+		},
+		Vendor: models.DeepscanWarningVendor{
+			ImportPath: injectedImport,
+			Name: fmt.Sprintf("%s.%s",
+				imp.Target.Definition.Pkg,
+				imp.Target.StructName,
+			),
+			InjectionAST:  imp.Injector.CodeName,
+			Injection:     imp.Injector.ParamDefinition.Place,
+			InjectionPath: c.definitionToRelPath(imp.Injector.ParamDefinition.Place),
+			SourceCodePreview: c.renderCode(
+				imp.Injector.ParamDefinition.Place,
+				imp.Injector.MethodDefinition.Place,
+				imp.Injector.ParamDefinition.Place,
+			),
+		},
+		Target: models.DeepscanWarningTarget{
+			Definition: imp.Target.Definition.Place,
+			RelativePath: fmt.Sprintf("%s/%s:%d",
+				injectedImport,
+				filepath.Base(imp.Target.Definition.Place.File),
+				imp.Target.Definition.Place.Line,
+			),
+		},
+	}
+
+	c.result.DeepscanVendorWarnings = append(c.result.DeepscanVendorWarnings, warn)
+	return nil
+}
+
+// isStdImportPath check that import path is from std lib, by go convention
+// only std packages have no dot in first path element (example: `database/sql`)
+func isStdImportPath(importPath string) bool {
+	firstElement, _, _ := strings.Cut(importPath, "/")
+	return !strings.Contains(firstElement, ".")
+}
+
+func (c *DeepScan) isProjectImport(importPath string) bool {
+	moduleName := c.spec.ModuleName.Value
+	return importPath == moduleName || strings.HasPrefix(importPath, moduleName+"/")
+}
+
+// gateName is method name for function params, and
+// struct field selector for struct gates (example: `Service.Repo`)
+func (c *DeepScan) gateName(gate *deepscan.Gate) string {
+	if gate.IsField {
+		return fmt.Sprintf("%s.%s", gate.MethodName, gate.ParamName)
+	}
+
+	return gate.MethodName
+}
+
 func (c *DeepScan) renderCode(pointer, from, to common.Reference) []byte {
 	return c.sourceCodeRenderer.SourceCode(
 		common.NewReferenceRange(pointer.File, from.Line, pointer.Line, to.Line),
@@ -315,6 +528,7 @@ func (c *DeepScan) findUsages(_ context.Context, absPackagePath string) ([]deeps
 		deepscan.WithAnalyseScope(scanDirectory),
 		deepscan.WithExcludedPath(excludeDirectories),
 		deepscan.WithExcludedFileMatchers(excludeMatchers),
+		deepscan.WithFlowDepth(c.spec.Allow.DeepScanDepth.Value),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed prepare scan criteria: %w", err)
@@ -0,0 +1,133 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	Features struct {
+		projectFilesResolver projectFilesResolver
+	}
+
+	featureUsage struct {
+		feature   string
+		reference common.Reference
+	}
+)
+
+// cgoImportPath is pseudo package of cgo, it's not std or vendor
+// package, so it's checked only by features checker ("allowCgo")
+const cgoImportPath = "C"
+
+var (
+	featureImports = map[string]string{
+		cgoImportPath: models.FeatureCgo,
+		"unsafe":      models.FeatureUnsafe,
+		"reflect":     models.FeatureReflect,
+	}
+
+	featureDirectives = map[string]string{
+		"go:linkname": models.FeatureLinkname,
+		"go:embed":    models.FeatureEmbed,
+	}
+)
+
+func NewFeatures(
+	projectFilesResolver projectFilesResolver,
+) *Features {
+	return &Features{
+		projectFilesResolver: projectFilesResolver,
+	}
+}
+
+func (c *Features) Name() string {
+	return models.CheckerFeatures
+}
+
+func (c *Features) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	result := models.CheckResult{
+		FeatureWarnings: []models.CheckArchWarningFeature{},
+	}
+
+	if spec.Allow.LowLevelFeatures.Value {
+		// all features allowed for all components
+		return result, nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	components := make(map[string]arch.Component)
+	for _, component := range spec.Components {
+		components[component.Name.Value] = component
+	}
+
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			// not attached files is reported by imports checker
+			continue
+		}
+
+		component, ok := components[*projectFile.ComponentID]
+		if !ok {
+			return models.CheckResult{}, fmt.Errorf("not found component '%s' in map", *projectFile.ComponentID)
+		}
+
+		for _, usage := range usedFeatures(projectFile.File) {
+			if featureAllowed(component, usage.feature) {
+				continue
+			}
+
+			result.FeatureWarnings = append(result.FeatureWarnings, models.CheckArchWarningFeature{
+				ComponentName:    component.Name.Value,
+				Feature:          usage.feature,
+				FileRelativePath: strings.TrimPrefix(projectFile.File.Path, spec.RootDirectory.Value),
+				FileAbsolutePath: projectFile.File.Path,
+				Reference:        usage.reference,
+			})
+		}
+	}
+
+	sort.SliceStable(result.FeatureWarnings, func(i, j int) bool {
+		return result.FeatureWarnings[i].FileRelativePath < result.FeatureWarnings[j].FileRelativePath
+	})
+
+	return result, nil
+}
+
+func usedFeatures(file models.ProjectFile) []featureUsage {
+	usages := make([]featureUsage, 0)
+
+	for _, resolvedImport := range file.Imports {
+		if feature, ok := featureImports[resolvedImport.Name]; ok {
+			usages = append(usages, featureUsage{feature: feature, reference: resolvedImport.Reference})
+		}
+	}
+
+	for _, directive := range file.Directives {
+		if feature, ok := featureDirectives[directive.Name]; ok {
+			usages = append(usages, featureUsage{feature: feature, reference: directive.Reference})
+		}
+	}
+
+	return usages
+}
+
+func featureAllowed(component arch.Component, feature string) bool {
+	for _, allowed := range component.AllowedFeatures {
+		if allowed.Value == feature {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,52 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func Test_usedFeatures(t *testing.T) {
+	file := models.ProjectFile{
+		Imports: []models.ResolvedImport{
+			{Name: "C"},
+			{Name: "fmt"},
+			{Name: "unsafe"},
+			{Name: "reflect"},
+			{Name: "github.com/vendor/reflect"},
+		},
+		Directives: []models.FileDirective{
+			{Name: "go:generate"},
+			{Name: "go:linkname"},
+			{Name: "go:embed"},
+		},
+	}
+
+	features := make([]string, 0)
+	for _, usage := range usedFeatures(file) {
+		features = append(features, usage.feature)
+	}
+
+	assert.Equal(t, []string{
+		models.FeatureCgo,
+		models.FeatureUnsafe,
+		models.FeatureReflect,
+		models.FeatureLinkname,
+		models.FeatureEmbed,
+	}, features)
+}
+
+func Test_featureAllowed(t *testing.T) {
+	component := arch.Component{
+		AllowedFeatures: []common.Referable[string]{
+			common.NewEmptyReferable(models.FeatureCgo),
+		},
+	}
+
+	assert.True(t, featureAllowed(component, models.FeatureCgo))
+	assert.False(t, featureAllowed(component, models.FeatureUnsafe))
+}
@@ -3,6 +3,7 @@ package checker
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
@@ -13,6 +14,7 @@ import (
 type Imports struct {
 	spec                 arch.Spec
 	projectFilesResolver projectFilesResolver
+	importedComponents   map[string]string // import path -> component name
 	result               results
 }
 
@@ -25,6 +27,10 @@ func NewImport(
 	}
 }
 
+func (c *Imports) Name() string {
+	return models.CheckerImports
+}
+
 func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
 	c.spec = spec
 
@@ -33,6 +39,7 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
 	}
 
+	c.importedComponents = assembleImportedComponentsMap(spec, assemblePackageComponentsMap(projectFiles))
 	components := c.assembleComponentsMap(spec)
 
 	for _, projectFile := range projectFiles {
@@ -59,6 +66,11 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 		return models.CheckResult{}, fmt.Errorf("not found component '%s' in map", componentID)
 	}
 
+	err = c.checkModules(spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed check go.mod modules: %w", err)
+	}
+
 	return c.result.assembleSortedResults(), nil
 }
 
@@ -66,7 +78,60 @@ func (c *Imports) assembleComponentsMap(spec arch.Spec) map[string]arch.Componen
 	results := make(map[string]arch.Component)
 
 	for _, component := range spec.Components {
-		results[component.Name.Value] = component
+		results[component.Name.Value] = HoldDeniedImports(component, c.importedComponents)
+	}
+
+	return results
+}
+
+// HoldDeniedImports keep in component denied imports only packages, that is
+// held by restricting component (importedComponents is import path -> component).
+// Components paths can be nested ("billing/**" and "billing/api"), so package
+// can match to restricting component path, but belong to other component
+func HoldDeniedImports(component arch.Component, importedComponents map[string]string) arch.Component {
+	held := make([]common.Referable[arch.DeniedImport], 0, len(component.DeniedProjectImports))
+
+	for _, deniedImport := range component.DeniedProjectImports {
+		holder, ok := importedComponents[deniedImport.Value.ResolvedPath.ImportPath]
+		if !ok || holder != deniedImport.Value.ComponentName {
+			continue
+		}
+
+		held = append(held, deniedImport)
+	}
+
+	component.DeniedProjectImports = held
+	return component
+}
+
+// assemblePackageComponentsMap map package abs path to
+// component, that own files of this package
+func assemblePackageComponentsMap(projectFiles []models.FileHold) map[string]string {
+	results := make(map[string]string)
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		results[filepath.Dir(projectFile.File.Path)] = *projectFile.ComponentID
+	}
+
+	return results
+}
+
+// assembleImportedComponentsMap map project import path to component, that
+// own files of imported package
+func assembleImportedComponentsMap(spec arch.Spec, packageComponents map[string]string) map[string]string {
+	results := make(map[string]string)
+	for _, component := range spec.Components {
+		for _, resolvedPath := range component.ResolvedPaths {
+			componentID, ok := packageComponents[resolvedPath.Value.AbsPath]
+			if !ok {
+				continue
+			}
+
+			results[resolvedPath.Value.ImportPath] = componentID
+		}
 	}
 
 	return results
@@ -74,7 +139,7 @@ func (c *Imports) assembleComponentsMap(spec arch.Spec) map[string]arch.Componen
 
 func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) error {
 	for _, resolvedImport := range file.Imports {
-		allowed, err := checkImport(component, resolvedImport, c.spec.Allow.DepOnAnyVendor.Value)
+		allowed, err := checkImport(component, resolvedImport, c.spec.Allow.DepOnAnyVendor.Value, c.spec.GoModules)
 		if err != nil {
 			return fmt.Errorf("failed check import '%s': %w",
 				resolvedImport.Name,
@@ -86,27 +151,77 @@ func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) e
 			continue
 		}
 
+		importedComponentName := ""
+		if resolvedImport.ImportType == models.ImportTypeProject {
+			importedComponentName = c.importedComponents[resolvedImport.Name]
+		}
+
 		c.result.addDependencyWarning(models.CheckArchWarningDependency{
-			Reference:          resolvedImport.Reference,
-			ComponentName:      component.Name.Value,
-			FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
-			FileAbsolutePath:   file.Path,
-			ResolvedImportName: resolvedImport.Name,
+			Reference:             resolvedImport.Reference,
+			ComponentName:         component.Name.Value,
+			ImportedComponentName: importedComponentName,
+			FileRelativePath:      strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+			FileAbsolutePath:      file.Path,
+			ResolvedImportName:    resolvedImport.Name,
+			Reason:                ImportRejectReason(component, resolvedImport),
 		})
 	}
 
 	return nil
 }
 
+// CheckImport check that component may import resolvedImport, it's same
+// check, as in Imports checker, but for single import (used by go/analysis)
+func CheckImport(spec arch.Spec, component arch.Component, resolvedImport models.ResolvedImport) (bool, error) {
+	return checkImport(component, resolvedImport, spec.Allow.DepOnAnyVendor.Value, spec.GoModules)
+}
+
+// ImportRejectReason describe rule, that reject not allowed import. Reason is empty
+// for imports, rejected by component own rules (mayDependOn, canUse, etc..)
+func ImportRejectReason(component arch.Component, resolvedImport models.ResolvedImport) string {
+	if resolvedImport.ImportType != models.ImportTypeProject {
+		return ""
+	}
+
+	denied, ok := deniedProjectImport(component, resolvedImport)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("not in allowedImporters of component '%s'", denied.Value.ComponentName)
+}
+
 func checkImport(
 	component arch.Component,
 	resolvedImport models.ResolvedImport,
 	allowDependOnAnyVendor bool,
+	goModules []common.GoModule,
 ) (bool, error) {
+	if resolvedImport.Name == cgoImportPath {
+		return true, nil
+	}
+
 	switch resolvedImport.ImportType {
 	case models.ImportTypeStdLib:
-		return true, nil
+		forbidden, err := matchVendorGlobs(component.ForbiddenStdGlobs, resolvedImport)
+		return !forbidden, err
 	case models.ImportTypeVendor:
+		forbidden, err := matchVendorGlobs(component.ForbiddenVendorGlobs, resolvedImport)
+		if err != nil {
+			return false, err
+		}
+
+		if forbidden {
+			// forbidden vendors is not allowed even with "depOnAnyVendor"
+			return false, nil
+		}
+
+		if component.SpecialFlags.RestrictModules.Value {
+			// "allowModules" is defined, so it's exclusive list of allowed
+			// vendors ("canUse", "anyVendorDeps" and "depOnAnyVendor" is ignored)
+			return checkModuleImport(component, resolvedImport, goModules)
+		}
+
 		if allowDependOnAnyVendor {
 			return true, nil
 		}
@@ -124,7 +239,11 @@ func checkVendorImport(component arch.Component, resolvedImport models.ResolvedI
 		return true, nil
 	}
 
-	for _, vendorGlob := range component.AllowedVendorGlobs {
+	return matchVendorGlobs(component.AllowedVendorGlobs, resolvedImport)
+}
+
+func matchVendorGlobs(vendorGlobs []common.Referable[models.Glob], resolvedImport models.ResolvedImport) (bool, error) {
+	for _, vendorGlob := range vendorGlobs {
 		matched, err := vendorGlob.Value.Match(resolvedImport.Name)
 		if err != nil {
 			return false, models.NewReferableErr(
@@ -145,6 +264,12 @@ func checkVendorImport(component arch.Component, resolvedImport models.ResolvedI
 }
 
 func checkProjectImport(component arch.Component, resolvedImport models.ResolvedImport) bool {
+	if _, denied := deniedProjectImport(component, resolvedImport); denied {
+		// imported component restrict importers, this is
+		// not allowed even with "anyProjectDeps"
+		return false
+	}
+
 	if component.SpecialFlags.AllowAllProjectDeps.Value {
 		return true
 	}
@@ -159,3 +284,13 @@ func checkProjectImport(component arch.Component, resolvedImport models.Resolved
 
 	return false
 }
+
+func deniedProjectImport(component arch.Component, resolvedImport models.ResolvedImport) (common.Referable[arch.DeniedImport], bool) {
+	for _, deniedImport := range component.DeniedProjectImports {
+		if deniedImport.Value.ResolvedPath.ImportPath == resolvedImport.Name {
+			return deniedImport, true
+		}
+	}
+
+	return common.Referable[arch.DeniedImport]{}, false
+}
@@ -0,0 +1,209 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/version"
+)
+
+// checkModules match go.mod requirements to vendors, defined by `module`
+func (c *Imports) checkModules(spec arch.Spec) error {
+	for _, goModule := range spec.GoModules {
+		for _, vendor := range spec.Vendors {
+			matched, err := matchModuleGlobs(vendor.Modules, goModule.Path)
+			if err != nil {
+				return err
+			}
+
+			if !matched {
+				continue
+			}
+
+			warnings, err := checkModule(vendor, goModule)
+			if err != nil {
+				return fmt.Errorf("failed check module '%s': %w", goModule.Path, err)
+			}
+
+			for _, warning := range warnings {
+				c.result.addModuleWarning(warning)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkModule(vendor arch.Vendor, goModule common.GoModule) ([]models.CheckArchWarningModule, error) {
+	warnings := make([]models.CheckArchWarningModule, 0)
+	newWarning := func(reason string, ref common.Reference) models.CheckArchWarningModule {
+		return models.CheckArchWarningModule{
+			VendorName: vendor.Name.Value,
+			ModulePath: goModule.Path,
+			Version:    goModule.Version,
+			Reason:     reason,
+			Reference:  ref,
+		}
+	}
+
+	if vendor.Forbidden.Value {
+		warnings = append(warnings, newWarning("module is forbidden", goModule.Reference))
+	}
+
+	if vendor.Version.Value != "" {
+		constraint, err := version.ParseConstraint(vendor.Version.Value)
+		if err != nil {
+			return nil, models.NewReferableErr(
+				fmt.Errorf("invalid version constraint '%s': %w", vendor.Version.Value, err),
+				vendor.Version.Reference,
+			)
+		}
+
+		if !constraint.Match(goModule.Version) {
+			warnings = append(warnings, newWarning(
+				fmt.Sprintf("version not match to '%s'", vendor.Version.Value),
+				goModule.Reference,
+			))
+		}
+	}
+
+	if goModule.Replace != nil && goModule.Replace.Local && !vendor.AllowLocalReplace.Value {
+		warnings = append(warnings, newWarning(
+			fmt.Sprintf("module replaced with local directory '%s'", goModule.Replace.Path),
+			goModule.Replace.Reference,
+		))
+	}
+
+	return warnings, nil
+}
+
+// ModuleAllowed check that component may import any package of go.mod
+// module, it's used for modules report, not in checks
+func (c *Imports) ModuleAllowed(spec arch.Spec, component arch.Component, goModule common.GoModule) (bool, error) {
+	forbidden, err := matchVendorGlobs(component.ForbiddenVendorGlobs, models.ResolvedImport{Name: goModule.Path})
+	if err != nil {
+		return false, err
+	}
+
+	if forbidden {
+		return false, nil
+	}
+
+	if component.SpecialFlags.RestrictModules.Value {
+		return matchModuleGlobs(component.AllowedModules, goModule.Path)
+	}
+
+	if spec.Allow.DepOnAnyVendor.Value || component.SpecialFlags.AllowAllVendorDeps.Value {
+		return true, nil
+	}
+
+	return vendorGlobsCoverModule(component.AllowedVendorGlobs, goModule.Path)
+}
+
+// checkModuleImport allow vendor import, only when it's module is in
+// component "allowModules" list. Imports of not required modules
+// (not in go.mod) is never allowed
+func checkModuleImport(
+	component arch.Component,
+	resolvedImport models.ResolvedImport,
+	goModules []common.GoModule,
+) (bool, error) {
+	goModule := resolveModule(goModules, resolvedImport.Name)
+	if goModule == nil {
+		return false, nil
+	}
+
+	return matchModuleGlobs(component.AllowedModules, goModule.Path)
+}
+
+// resolveModule find go.mod module of import path (longest
+// module path is used, modules can be nested)
+func resolveModule(goModules []common.GoModule, importPath string) *common.GoModule {
+	var resolved *common.GoModule
+
+	for ind, goModule := range goModules {
+		if importPath != goModule.Path && !strings.HasPrefix(importPath, goModule.Path+"/") {
+			continue
+		}
+
+		if resolved == nil || len(goModule.Path) > len(resolved.Path) {
+			resolved = &goModules[ind]
+		}
+	}
+
+	return resolved
+}
+
+func matchModuleGlobs(moduleGlobs []common.Referable[models.Glob], modulePath string) (bool, error) {
+	for _, moduleGlob := range moduleGlobs {
+		matched, err := moduleGlob.Value.Match(modulePath)
+		if err != nil {
+			return false, models.NewReferableErr(
+				fmt.Errorf("invalid module glob '%s': %w",
+					string(moduleGlob.Value),
+					err,
+				),
+				moduleGlob.Reference,
+			)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// vendorGlobsCoverModule check that at least one package of
+// module (or module itself) can be matched by vendor glob
+func vendorGlobsCoverModule(vendorGlobs []common.Referable[models.Glob], modulePath string) (bool, error) {
+	for _, vendorGlob := range vendorGlobs {
+		covered, err := globCoversModule(vendorGlob.Value, modulePath)
+		if err != nil {
+			return false, models.NewReferableErr(
+				fmt.Errorf("invalid vendor glob '%s': %w",
+					string(vendorGlob.Value),
+					err,
+				),
+				vendorGlob.Reference,
+			)
+		}
+
+		if covered {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// globCoversModule match glob with module path segment by segment,
+// all module packages has module path as prefix, so if all module
+// segments is matched, some of packages can be matched by glob rest.
+// Super glob (**) can match any rest of path
+func globCoversModule(glob models.Glob, modulePath string) (bool, error) {
+	globSegments := strings.Split(string(glob), "/")
+	moduleSegments := strings.Split(modulePath, "/")
+
+	for ind, moduleSegment := range moduleSegments {
+		if ind >= len(globSegments) {
+			return false, nil
+		}
+
+		globSegment := globSegments[ind]
+		if superInd := strings.Index(globSegment, "**"); superInd != -1 {
+			return models.Glob(globSegment[:superInd] + "**").Match(strings.Join(moduleSegments[ind:], "/"))
+		}
+
+		matched, err := models.Glob(globSegment).Match(moduleSegment)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
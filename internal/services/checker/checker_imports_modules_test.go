@@ -0,0 +1,216 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func makeTestVendor(module string, version string, forbidden bool, allowLocalReplace bool) arch.Vendor {
+	return arch.Vendor{
+		Name:              common.NewEmptyReferable("vendor"),
+		Modules:           []common.Referable[models.Glob]{common.NewEmptyReferable(models.Glob(module))},
+		Version:           common.NewEmptyReferable(version),
+		Forbidden:         makeBool(forbidden),
+		AllowLocalReplace: makeBool(allowLocalReplace),
+	}
+}
+
+func Test_checkModule(t *testing.T) {
+	localReplace := &common.GoModuleReplace{Path: "../fork", Local: true}
+	remoteReplace := &common.GoModuleReplace{Path: "github.com/fork/lib", Version: "v1.0.0"}
+
+	tests := []struct {
+		name    string
+		vendor  arch.Vendor
+		module  common.GoModule
+		reasons []string
+	}{
+		{
+			name:    "no rules",
+			vendor:  makeTestVendor("github.com/vendor/lib", "", false, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.0.0"},
+			reasons: []string{},
+		},
+		{
+			name:    "forbidden",
+			vendor:  makeTestVendor("github.com/vendor/lib", "", true, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.0.0"},
+			reasons: []string{"module is forbidden"},
+		},
+		{
+			name:    "version match",
+			vendor:  makeTestVendor("github.com/vendor/lib", ">=1.4 <2", false, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.5.0"},
+			reasons: []string{},
+		},
+		{
+			name:    "version not match",
+			vendor:  makeTestVendor("github.com/vendor/lib", ">=1.4", false, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.3.9"},
+			reasons: []string{"version not match to '>=1.4'"},
+		},
+		{
+			name:    "local replace",
+			vendor:  makeTestVendor("github.com/vendor/lib", "", false, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.0.0", Replace: localReplace},
+			reasons: []string{"module replaced with local directory '../fork'"},
+		},
+		{
+			name:    "local replace allowed",
+			vendor:  makeTestVendor("github.com/vendor/lib", "", false, true),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.0.0", Replace: localReplace},
+			reasons: []string{},
+		},
+		{
+			name:    "remote replace",
+			vendor:  makeTestVendor("github.com/vendor/lib", "", false, false),
+			module:  common.GoModule{Path: "github.com/vendor/lib", Version: "v1.0.0", Replace: remoteReplace},
+			reasons: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, err := checkModule(tt.vendor, tt.module)
+			require.NoError(t, err)
+
+			reasons := make([]string, 0, len(warnings))
+			for _, warning := range warnings {
+				reasons = append(reasons, warning.Reason)
+			}
+
+			assert.Equal(t, tt.reasons, reasons)
+		})
+	}
+}
+
+func Test_checkImportForbiddenVendor(t *testing.T) {
+	component := arch.Component{
+		ForbiddenVendorGlobs: []common.Referable[models.Glob]{
+			common.NewEmptyReferable(models.Glob("github.com/vendor/lib/**")),
+		},
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllVendorDeps: makeBool(true),
+		},
+	}
+
+	allowed, err := checkImport(component, makeTestResolvedVendorImport("errors"), true, nil)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = checkImport(component, models.ResolvedImport{
+		Name:       "github.com/other/lib",
+		ImportType: models.ImportTypeVendor,
+	}, true, nil)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+}
+
+func Test_checkModuleImport(t *testing.T) {
+	goModules := []common.GoModule{
+		{Path: "github.com/vendor/lib"},
+		{Path: "github.com/vendor/lib/v2"},
+		{Path: "github.com/other/lib"},
+	}
+
+	component := arch.Component{
+		AllowedModules: []common.Referable[models.Glob]{
+			common.NewEmptyReferable(models.Glob("github.com/vendor/lib")),
+		},
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllVendorDeps: makeBool(true),
+			RestrictModules:    makeBool(true),
+		},
+	}
+
+	tests := []struct {
+		importPath string
+		want       bool
+	}{
+		{importPath: "github.com/vendor/lib", want: true},
+		{importPath: "github.com/vendor/lib/errors", want: true},
+		{importPath: "github.com/vendor/lib/v2", want: false},
+		{importPath: "github.com/vendor/lib/v2/errors", want: false},
+		{importPath: "github.com/other/lib", want: false},
+		{importPath: "github.com/not/required", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			got, err := checkImport(component, models.ResolvedImport{
+				Name:       tt.importPath,
+				ImportType: models.ImportTypeVendor,
+			}, true, goModules)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkModuleImportIgnoreCanUse(t *testing.T) {
+	goModules := []common.GoModule{
+		{Path: "github.com/vendor/lib"},
+	}
+
+	// allowModules: [], canUse: [lib]
+	component := arch.Component{
+		AllowedModules: []common.Referable[models.Glob]{},
+		AllowedVendorGlobs: []common.Referable[models.Glob]{
+			common.NewEmptyReferable(models.Glob("github.com/vendor/lib")),
+		},
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllVendorDeps: makeBool(false),
+			RestrictModules:    makeBool(true),
+		},
+	}
+
+	allowed, err := checkImport(component, models.ResolvedImport{
+		Name:       "github.com/vendor/lib",
+		ImportType: models.ImportTypeVendor,
+	}, false, goModules)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = checkImport(component, models.ResolvedImport{
+		Name:       "fmt",
+		ImportType: models.ImportTypeStdLib,
+	}, false, goModules)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = NewImport(nil).ModuleAllowed(arch.Spec{}, component, goModules[0])
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func Test_vendorGlobsCoverModule(t *testing.T) {
+	tests := []struct {
+		glob       string
+		modulePath string
+		want       bool
+	}{
+		{glob: "github.com/x/api", modulePath: "github.com/x/api", want: true},
+		{glob: "github.com/x/api/client", modulePath: "github.com/x/api", want: true},
+		{glob: "github.com/x/*/client", modulePath: "github.com/x/api", want: true},
+		{glob: "github.com/x/*/client", modulePath: "github.com/x/a/b", want: false},
+		{glob: "github.com/x/api-*", modulePath: "github.com/x/api-v2", want: true},
+		{glob: "github.com/x/**", modulePath: "github.com/x/a/b", want: true},
+		{glob: "github.com/**/client", modulePath: "github.com/x/api", want: true},
+		{glob: "github.com/y/**", modulePath: "github.com/x/api", want: false},
+		{glob: "github.com/x", modulePath: "github.com/x/api", want: false},
+		{glob: "github.com/x/apis/client", modulePath: "github.com/x/api", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.glob+" "+tt.modulePath, func(t *testing.T) {
+			got, err := vendorGlobsCoverModule([]common.Referable[models.Glob]{
+				common.NewEmptyReferable(models.Glob(tt.glob)),
+			}, tt.modulePath)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -401,7 +401,7 @@ func TestChecker_checkImport(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor)
+			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -419,6 +419,124 @@ func TestChecker_checkImport(t *testing.T) {
 			ImportType: 100,
 		}
 
-		_, _ = checkImport(cmp, resolvedImport, false)
+		_, _ = checkImport(cmp, resolvedImport, false, nil)
 	})
 }
+
+func TestChecker_checkImportForbiddenStd(t *testing.T) {
+	cmp := arch.Component{
+		ForbiddenStdGlobs: []common.Referable[models.Glob]{
+			common.NewEmptyReferable(models.Glob("net/**")),
+			common.NewEmptyReferable(models.Glob("unsafe")),
+		},
+	}
+
+	tests := []struct {
+		importPath string
+		want       bool
+	}{
+		{importPath: "fmt", want: true},
+		{importPath: "net", want: true},
+		{importPath: "net/http", want: false},
+		{importPath: "net/http/httptest", want: false},
+		{importPath: "unsafe", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			got, err := checkImport(cmp, models.ResolvedImport{
+				Name:       tt.importPath,
+				ImportType: models.ImportTypeStdLib,
+			}, true, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChecker_checkImportCgo(t *testing.T) {
+	cmp := arch.Component{
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllVendorDeps: makeBool(false),
+			RestrictModules:    makeBool(true),
+		},
+	}
+
+	// "C" is checked by features checker, scanner classify it as vendor
+	got, err := checkImport(cmp, models.ResolvedImport{
+		Name:       "C",
+		ImportType: models.ImportTypeVendor,
+	}, false, nil)
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
+
+func TestChecker_checkImportDeniedByImporters(t *testing.T) {
+	cmp := arch.Component{
+		Name: common.NewEmptyReferable("app"),
+		AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+			makeTestResolvedPath("billing"),
+			makeTestResolvedPath("models"),
+		},
+		DeniedProjectImports: []common.Referable[arch.DeniedImport]{
+			common.NewEmptyReferable(arch.DeniedImport{
+				ComponentName: "billing",
+				ResolvedPath:  makeTestResolvedPath("billing").Value,
+			}),
+		},
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllProjectDeps: makeBool(true),
+		},
+	}
+
+	tests := []struct {
+		localPath  string
+		want       bool
+		wantReason string
+	}{
+		{localPath: "models", want: true, wantReason: ""},
+		{localPath: "billing", want: false, wantReason: "not in allowedImporters of component 'billing'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.localPath, func(t *testing.T) {
+			resolvedImport := makeTestResolvedProjectImport(tt.localPath)
+
+			got, err := checkImport(cmp, resolvedImport, false, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantReason, ImportRejectReason(cmp, resolvedImport))
+		})
+	}
+}
+
+func TestHoldDeniedImports(t *testing.T) {
+	cmp := arch.Component{
+		Name: common.NewEmptyReferable("checkout"),
+		DeniedProjectImports: []common.Referable[arch.DeniedImport]{
+			common.NewEmptyReferable(arch.DeniedImport{
+				ComponentName: "billing",
+				ResolvedPath:  makeTestResolvedPath("billing").Value,
+			}),
+			common.NewEmptyReferable(arch.DeniedImport{
+				ComponentName: "billing",
+				ResolvedPath:  makeTestResolvedPath("billing/api").Value,
+			}),
+		},
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllProjectDeps: makeBool(true),
+		},
+	}
+
+	// "billing/**" and "billing/api" is nested, api package is held by billingapi
+	held := HoldDeniedImports(cmp, map[string]string{
+		testModulePath + "/billing":     "billing",
+		testModulePath + "/billing/api": "billingapi",
+	})
+
+	got, err := checkImport(held, makeTestResolvedProjectImport("billing"), false, nil)
+	assert.NoError(t, err)
+	assert.False(t, got)
+
+	got, err = checkImport(held, makeTestResolvedProjectImport("billing/api"), false, nil)
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
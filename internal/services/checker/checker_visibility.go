@@ -0,0 +1,203 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+)
+
+type Visibility struct {
+	projectFilesResolver projectFilesResolver
+	scanner              *deepscan.Searcher
+
+	sync.Mutex
+}
+
+func NewVisibility(projectFilesResolver projectFilesResolver) *Visibility {
+	return &Visibility{
+		projectFilesResolver: projectFilesResolver,
+		scanner:              deepscan.NewSearcher(),
+	}
+}
+
+func (c *Visibility) Name() string {
+	return models.CheckerVisibility
+}
+
+func (c *Visibility) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	c.Mutex.Lock()
+	defer c.Mutex.Unlock()
+
+	result := models.CheckResult{
+		VisibilityWarnings: []models.CheckArchWarningVisibility{},
+	}
+
+	if !hasPrivateComponents(spec) {
+		return result, nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	components := make(map[string]arch.Component)
+	for _, component := range spec.Components {
+		components[component.Name.Value] = component
+	}
+
+	packageComponents := assemblePackageComponentsMap(projectFiles)
+	importedComponents := assembleImportedComponentsMap(spec, packageComponents)
+
+	// -- private components imports
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			// not attached files is reported by imports checker
+			continue
+		}
+
+		for _, resolvedImport := range projectFile.File.Imports {
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				continue
+			}
+
+			targetID, ok := importedComponents[resolvedImport.Name]
+			if !ok || targetID == *projectFile.ComponentID {
+				continue
+			}
+
+			target := components[targetID]
+			if !target.Private.Value {
+				continue
+			}
+
+			parentDirectory := filepath.Dir(componentRootDirectory(target))
+			if isSubDirectory(parentDirectory, filepath.Dir(projectFile.File.Path)) {
+				continue
+			}
+
+			result.VisibilityWarnings = append(result.VisibilityWarnings, models.CheckArchWarningVisibility{
+				ComponentName:        *projectFile.ComponentID,
+				PrivateComponentName: targetID,
+				Reason: fmt.Sprintf("imported outside of parent directory '%s'",
+					strings.TrimPrefix(parentDirectory, spec.RootDirectory.Value),
+				),
+				FileRelativePath: strings.TrimPrefix(projectFile.File.Path, spec.RootDirectory.Value),
+				FileAbsolutePath: projectFile.File.Path,
+				Reference:        resolvedImport.Reference,
+			})
+		}
+	}
+
+	// -- private types in exported API
+	for _, component := range spec.Components {
+		if component.Private.Value {
+			continue
+		}
+
+		for _, resolvedPath := range component.ResolvedPaths {
+			absPath := resolvedPath.Value.AbsPath
+			if packageComponents[absPath] != component.Name.Value {
+				// excluded, or owned by another component
+				continue
+			}
+
+			exposedTypes, err := c.exposedTypes(absPath)
+			if err != nil {
+				return models.CheckResult{}, fmt.Errorf("failed find exposed types in '%s': %w", absPath, err)
+			}
+
+			for _, exposedType := range exposedTypes {
+				targetID, ok := packageComponents[exposedType.TypeDefinition.Path]
+				if !ok || targetID == component.Name.Value || !components[targetID].Private.Value {
+					continue
+				}
+
+				place := exposedType.Definition.Place
+				result.VisibilityWarnings = append(result.VisibilityWarnings, models.CheckArchWarningVisibility{
+					ComponentName:        component.Name.Value,
+					PrivateComponentName: targetID,
+					Reason:               fmt.Sprintf("type '%s' exposed in exported '%s'", exposedType.TypeName, exposedType.Name),
+					FileRelativePath:     strings.TrimPrefix(place.File, spec.RootDirectory.Value),
+					FileAbsolutePath:     place.File,
+					Reference:            place,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(result.VisibilityWarnings, func(i, j int) bool {
+		a, b := result.VisibilityWarnings[i], result.VisibilityWarnings[j]
+		if a.FileRelativePath != b.FileRelativePath {
+			return a.FileRelativePath < b.FileRelativePath
+		}
+
+		return a.Reference.Line < b.Reference.Line
+	})
+
+	return result, nil
+}
+
+func (c *Visibility) exposedTypes(absPackagePath string) ([]deepscan.ExposedType, error) {
+	criteria, err := deepscan.NewCriteria(
+		deepscan.WithPackagePath(absPackagePath),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed prepare scan criteria: %w", err)
+	}
+
+	exposedTypes, err := c.scanner.ExposedTypes(criteria)
+	if err != nil {
+		return nil, fmt.Errorf("scan failed: %w", err)
+	}
+
+	return exposedTypes, nil
+}
+
+func hasPrivateComponents(spec arch.Spec) bool {
+	for _, component := range spec.Components {
+		if component.Private.Value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// componentRootDirectory is the closest common directory
+// of all component packages, example:
+//
+//	[internal/storage/db, internal/storage/db/pg] -> internal/storage/db
+func componentRootDirectory(component arch.Component) string {
+	root := ""
+
+	for _, resolvedPath := range component.ResolvedPaths {
+		absPath := resolvedPath.Value.AbsPath
+		if root == "" {
+			root = absPath
+			continue
+		}
+
+		for !isSubDirectory(root, absPath) {
+			root = filepath.Dir(root)
+		}
+	}
+
+	return root
+}
+
+// isSubDirectory check that directory is equal to parent, or located inside it
+func isSubDirectory(parent string, directory string) bool {
+	if directory == parent {
+		return true
+	}
+
+	return strings.HasPrefix(directory, strings.TrimSuffix(parent, string(filepath.Separator))+string(filepath.Separator))
+}
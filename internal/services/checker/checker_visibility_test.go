@@ -0,0 +1,31 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func Test_componentRootDirectory(t *testing.T) {
+	component := arch.Component{
+		ResolvedPaths: []common.Referable[models.ResolvedPath]{
+			common.NewEmptyReferable(models.ResolvedPath{AbsPath: "/project/internal/storage/db"}),
+			common.NewEmptyReferable(models.ResolvedPath{AbsPath: "/project/internal/storage/db/pg"}),
+			common.NewEmptyReferable(models.ResolvedPath{AbsPath: "/project/internal/storage/dbx"}),
+		},
+	}
+
+	assert.Equal(t, "/project/internal/storage", componentRootDirectory(component))
+}
+
+func Test_isSubDirectory(t *testing.T) {
+	assert.True(t, isSubDirectory("/project/internal", "/project/internal"))
+	assert.True(t, isSubDirectory("/project/internal", "/project/internal/app"))
+	assert.True(t, isSubDirectory("/", "/project"))
+	assert.False(t, isSubDirectory("/project/internal", "/project/internals"))
+	assert.False(t, isSubDirectory("/project/internal/app", "/project/internal"))
+}
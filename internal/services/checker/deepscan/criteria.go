@@ -38,6 +38,12 @@ type Criteria struct {
 	// exclude regexp matchers, for each file in analyseScope
 	// all rejected files will not be parsed
 	excludeFileMatchers []*regexp.Regexp
+
+	// optional (default 0 = off)
+	// how many nested function calls will be followed
+	// when injected value typed by interface, example:
+	// `NewService(provideRepo())` where `provideRepo() Repo { return &Memory{} }`
+	flowDepth int
 }
 
 type CriteriaArg = func(*Criteria)
@@ -99,6 +105,15 @@ func WithExcludedFileMatchers(matchers []*regexp.Regexp) CriteriaArg {
 	}
 }
 
+// WithFlowDepth define how deep data flow analyse will go
+// into factories and closures, for resolving concrete
+// implementation of values typed by interface
+func WithFlowDepth(depth int) CriteriaArg {
+	return func(criteria *Criteria) {
+		criteria.flowDepth = depth
+	}
+}
+
 func fillDefaultCriteriaFields(criteria *Criteria) error {
 	if criteria.moduleName == "" || criteria.moduleRootPath == "" {
 		moduleName, root, err := findRootPath(criteria.packagePath)
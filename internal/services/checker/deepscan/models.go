@@ -6,9 +6,10 @@ import (
 
 type (
 	InjectionMethod struct {
-		Name       string // method name (example: `NewProcessor`)
+		Name       string // method or struct name (example: `NewProcessor`)
 		Definition Source // where method is defined
-		Gates      []Gate // method params with interface type
+		Gates      []Gate // method params or struct fields with interface type
+		IsStruct   bool   // gates is struct fields (type Processor struct { Repo myInterface })
 	}
 
 	Gate struct {
@@ -20,6 +21,8 @@ type (
 		Interface          Interface        // used interface for injection
 		Implementations    []Implementation // all code links to this param
 		IsVariadic         bool             // function param is variadic (func (a bool, nums ...int))
+		IsField            bool             // exported struct field, MethodName is struct name and ParamName is field name
+		IsFunc             bool             // function typed param (func (handler func(ctx) error)), Interface is function signature
 	}
 
 	Interface struct {
@@ -40,7 +43,7 @@ type (
 	}
 
 	Target struct {
-		StructName string // interface implementation type name
+		StructName string // interface implementation type name (or function name, for function gates)
 		Definition Source // where this type defined
 	}
 
@@ -51,3 +54,20 @@ type (
 		Place  common.Reference // exactly place in source code
 	}
 )
+
+type (
+	// ExposedType is named type from other package, used in
+	// exported API of package (example: `func NewRepo() *db.Conn`)
+	ExposedType struct {
+		Name           string // exported API name (example: `NewRepo` or `Repo.Conn`)
+		Definition     Source // where exported API is defined
+		TypeName       string // exposed type with package (example: `db.Conn`)
+		TypeDefinition Source // where exposed type is defined
+	}
+
+	// DeclaredTypes is count of named types, declared in package
+	DeclaredTypes struct {
+		Interfaces int
+		Concrete   int
+	}
+)
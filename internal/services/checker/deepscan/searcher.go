@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"path"
 	"path/filepath"
 	"strings"
@@ -12,6 +13,7 @@ import (
 
 	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
 )
 
 type (
@@ -45,6 +47,10 @@ type (
 
 		// parsed fileset
 		fileSet *token.FileSet
+
+		// SSA code of parsed packages, used for
+		// data flow analyse (nil, when package can`t be built)
+		ssaPackages map[*packages.Package]*ssa.Package
 	}
 )
 
@@ -54,6 +60,7 @@ func NewSearcher() *Searcher {
 			parsedImports:  []*ast.Package{},
 			parsedPackages: map[absPath]*packages.Package{},
 			fileSet:        token.NewFileSet(),
+			ssaPackages:    map[*packages.Package]*ssa.Package{},
 		},
 	}
 }
@@ -111,6 +118,35 @@ func (s *Searcher) sourceFromToken(pos token.Pos) Source {
 	}
 }
 
+// sourceFromObject is same as sourceFromToken, but also
+// resolve real import path for objects outside of project
+// module (vendor and std types)
+func (s *Searcher) sourceFromObject(object types.Object) Source {
+	source := s.sourceFromToken(object.Pos())
+	if object.Pkg() == nil || !source.Place.Valid {
+		return source
+	}
+
+	if s.isProjectPath(source.Path) {
+		return source
+	}
+
+	source.Pkg = object.Pkg().Name()
+	source.Import = object.Pkg().Path()
+	return source
+}
+
+func (s *Searcher) isProjectPath(packagePath string) bool {
+	rootPath := s.ctx.criteria.moduleRootPath
+	vendorPath := filepath.Join(rootPath, "vendor")
+
+	if packagePath == vendorPath || strings.HasPrefix(packagePath, vendorPath+string(filepath.Separator)) {
+		return false
+	}
+
+	return packagePath == rootPath || strings.HasPrefix(packagePath, rootPath+string(filepath.Separator))
+}
+
 func (s *Searcher) pathToImport(packagePath string) string {
 	packagePath = strings.TrimPrefix(packagePath, s.ctx.criteria.moduleRootPath)
 	packagePath = strings.TrimPrefix(packagePath, string(filepath.Separator))
@@ -0,0 +1,231 @@
+package deepscan
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/ssa"
+)
+
+type (
+	// flowTracer walk back by SSA values, from injected
+	// interface value to places, where concrete type
+	// is converted into interface
+	flowTracer struct {
+		maxDepth int
+		visited  map[ssa.Value]struct{}
+	}
+)
+
+// resolveFlowTypes return all concrete types, that can be
+// stored in interface typed expression. Example:
+//
+//	repo := provideRepo()  // func provideRepo() Repo { return &Memory{} }
+//	NewService(repo)       // expr=repo, result=[*Memory]
+func (s *Searcher) resolveFlowTypes(astPackage *packages.Package, astFile *ast.File, expr ast.Expr) []types.Type {
+	if s.ctx.criteria.flowDepth <= 0 {
+		return nil
+	}
+
+	ssaPackage := s.cachedSSAPackage(astPackage)
+	if ssaPackage == nil {
+		return nil
+	}
+
+	expr = astutil.Unparen(expr)
+	path, _ := astutil.PathEnclosingInterval(astFile, expr.Pos(), expr.End())
+
+	fn := ssa.EnclosingFunction(ssaPackage, path)
+	if fn == nil {
+		return nil
+	}
+
+	value, _ := fn.ValueForExpr(expr)
+	if value == nil {
+		return nil
+	}
+
+	tracer := &flowTracer{
+		maxDepth: s.ctx.criteria.flowDepth,
+		visited:  map[ssa.Value]struct{}{},
+	}
+
+	unique := make([]types.Type, 0)
+	for _, found := range tracer.concreteTypes(value, 0) {
+		exist := false
+		for _, known := range unique {
+			if types.Identical(known, found) {
+				exist = true
+				break
+			}
+		}
+
+		if !exist {
+			unique = append(unique, found)
+		}
+	}
+
+	return unique
+}
+
+// cachedSSAPackage build SSA code for package, all package
+// imports is created only from types, without function bodies,
+// so data flow analyse is limited by current package
+func (s *Searcher) cachedSSAPackage(astPackage *packages.Package) (ssaPackage *ssa.Package) {
+	if ssaPackage, exist := s.ctx.ssaPackages[astPackage]; exist {
+		return ssaPackage
+	}
+
+	defer func() {
+		if recover() != nil {
+			// package imports loaded from export data can be incomplete
+			// and ssa builder will panic on them, in this case
+			// data flow is just not available for this package
+			ssaPackage = nil
+			s.ctx.ssaPackages[astPackage] = nil
+		}
+	}()
+
+	if len(astPackage.Errors) > 0 || astPackage.Types == nil {
+		// ssa builder require well typed code
+		s.ctx.ssaPackages[astPackage] = nil
+		return nil
+	}
+
+	program := ssa.NewProgram(s.ctx.fileSet, ssa.GlobalDebug)
+	created := map[*types.Package]struct{}{}
+
+	var createImports func(imports []*types.Package)
+	createImports = func(imports []*types.Package) {
+		for _, imp := range imports {
+			if _, exist := created[imp]; exist {
+				continue
+			}
+
+			created[imp] = struct{}{}
+			program.CreatePackage(imp, nil, nil, true)
+			createImports(imp.Imports())
+		}
+	}
+
+	createImports(astPackage.Types.Imports())
+
+	ssaPackage = program.CreatePackage(astPackage.Types, astPackage.Syntax, astPackage.TypesInfo, false)
+	ssaPackage.Build()
+
+	s.ctx.ssaPackages[astPackage] = ssaPackage
+	return ssaPackage
+}
+
+func (t *flowTracer) concreteTypes(value ssa.Value, depth int) []types.Type {
+	if _, visited := t.visited[value]; visited {
+		return nil
+	}
+
+	t.visited[value] = struct{}{}
+
+	if !types.IsInterface(value.Type()) {
+		return []types.Type{value.Type()}
+	}
+
+	switch v := value.(type) {
+	// `var r Repo = &Memory{}`
+	case *ssa.MakeInterface:
+		return t.concreteTypes(v.X, depth)
+
+	// `var r Repo = anotherInterface`
+	case *ssa.ChangeInterface:
+		return t.concreteTypes(v.X, depth)
+
+	// `r.(Repo)`
+	case *ssa.TypeAssert:
+		return t.concreteTypes(v.X, depth)
+
+	// `if a { r = &Memory{} } else { r = &Postgres{} }`
+	case *ssa.Phi:
+		result := make([]types.Type, 0, len(v.Edges))
+		for _, edge := range v.Edges {
+			result = append(result, t.concreteTypes(edge, depth)...)
+		}
+
+		return result
+
+	// `*r` (variables captured by closures or with taken address)
+	case *ssa.UnOp:
+		if v.Op != token.MUL {
+			return nil
+		}
+
+		return t.storedTypes(v.X, depth)
+
+	// `provideRepo()` or `func() Repo { .. }()`
+	case *ssa.Call:
+		return t.returnedTypes(v.Call.StaticCallee(), 0, depth)
+
+	// `repo, err := provideRepo()`
+	case *ssa.Extract:
+		call, ok := v.Tuple.(*ssa.Call)
+		if !ok {
+			return nil
+		}
+
+		return t.returnedTypes(call.Call.StaticCallee(), v.Index, depth)
+	}
+
+	// params, globals, struct fields, etc..
+	// can`t be resolved in current function
+	return nil
+}
+
+func (t *flowTracer) storedTypes(addr ssa.Value, depth int) []types.Type {
+	alloc, ok := addr.(*ssa.Alloc)
+	if !ok {
+		return nil
+	}
+
+	result := make([]types.Type, 0)
+	for _, instr := range *alloc.Referrers() {
+		store, ok := instr.(*ssa.Store)
+		if !ok || store.Addr != alloc {
+			continue
+		}
+
+		result = append(result, t.concreteTypes(store.Val, depth)...)
+	}
+
+	return result
+}
+
+func (t *flowTracer) returnedTypes(callee *ssa.Function, resultIndex int, depth int) []types.Type {
+	if callee == nil || callee.Blocks == nil {
+		// dynamic call or function without body (from another package)
+		return nil
+	}
+
+	if depth >= t.maxDepth {
+		return nil
+	}
+
+	result := make([]types.Type, 0)
+	for _, block := range callee.Blocks {
+		if len(block.Instrs) == 0 {
+			continue
+		}
+
+		ret, ok := block.Instrs[len(block.Instrs)-1].(*ssa.Return)
+		if !ok {
+			continue
+		}
+
+		if resultIndex >= len(ret.Results) {
+			continue
+		}
+
+		result = append(result, t.concreteTypes(ret.Results[resultIndex], depth+1)...)
+	}
+
+	return result
+}
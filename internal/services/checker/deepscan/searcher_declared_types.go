@@ -0,0 +1,46 @@
+package deepscan
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// DeclaredTypes count named types, declared in package scope. Interfaces
+// (including generic constraints) and concrete types are counted separately,
+// type aliases are skipped, because they not declare new types
+//
+// Can`t search from multiple goroutines, but safe for concurrent use (mutex inside)
+func (s *Searcher) DeclaredTypes(c Criteria) (DeclaredTypes, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// current ctx
+	s.ctx.criteria = c
+
+	astPackage, err := cachedPackage(s.ctx, c.packagePath)
+	if err != nil {
+		return DeclaredTypes{}, fmt.Errorf("failed get package at '%s': %w", c.packagePath, err)
+	}
+
+	declared := DeclaredTypes{}
+	if astPackage.Types == nil {
+		return declared, nil
+	}
+
+	scope := astPackage.Types.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() {
+			continue
+		}
+
+		if types.IsInterface(typeName.Type()) {
+			declared.Interfaces++
+			continue
+		}
+
+		declared.Concrete++
+	}
+
+	return declared, nil
+}
@@ -0,0 +1,179 @@
+package deepscan
+
+import (
+	"fmt"
+	"go/types"
+)
+
+type exposedTypesCollector struct {
+	searcher *Searcher
+	pkg      *types.Package
+	visited  map[types.Type]struct{}
+	found    []ExposedType
+}
+
+// ExposedTypes return all named types from other packages, that used
+// in exported API of package, this includes:
+//   - exported functions signatures
+//   - exported variables and constants types
+//   - exported types aliases
+//   - exported fields and methods of exported types
+//
+// Can`t search from multiple goroutines, but safe for concurrent use (mutex inside)
+func (s *Searcher) ExposedTypes(c Criteria) ([]ExposedType, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// current ctx
+	s.ctx.criteria = c
+
+	astPackage, err := cachedPackage(s.ctx, c.packagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed get package at '%s': %w", c.packagePath, err)
+	}
+
+	if astPackage.Types == nil {
+		return []ExposedType{}, nil
+	}
+
+	collector := &exposedTypesCollector{
+		searcher: s,
+		pkg:      astPackage.Types,
+		found:    []ExposedType{},
+	}
+
+	scope := astPackage.Types.Scope()
+	for _, name := range scope.Names() {
+		object := scope.Lookup(name)
+		if !object.Exported() {
+			continue
+		}
+
+		collector.collectObject(object)
+	}
+
+	return collector.found, nil
+}
+
+func (ec *exposedTypesCollector) collectObject(object types.Object) {
+	switch typed := object.(type) {
+	case *types.Func, *types.Var, *types.Const:
+		ec.collect(object.Name(), object, object.Type())
+	case *types.TypeName:
+		if typed.IsAlias() {
+			ec.collect(object.Name(), object, object.Type())
+			return
+		}
+
+		named, ok := object.Type().(*types.Named)
+		if !ok {
+			return
+		}
+
+		ec.collectUnderlying(object.Name(), object, named.Underlying())
+
+		for ind := 0; ind < named.NumMethods(); ind++ {
+			method := named.Method(ind)
+			if !method.Exported() {
+				continue
+			}
+
+			ec.collect(fmt.Sprintf("%s.%s", object.Name(), method.Name()), method, method.Type())
+		}
+	}
+}
+
+// collectUnderlying check declared type body, exported struct
+// fields and interface methods is part of API
+func (ec *exposedTypesCollector) collectUnderlying(name string, object types.Object, underlying types.Type) {
+	switch typed := underlying.(type) {
+	case *types.Struct:
+		for ind := 0; ind < typed.NumFields(); ind++ {
+			field := typed.Field(ind)
+			if !field.Exported() {
+				continue
+			}
+
+			ec.collect(fmt.Sprintf("%s.%s", name, field.Name()), field, field.Type())
+		}
+	case *types.Interface:
+		for ind := 0; ind < typed.NumExplicitMethods(); ind++ {
+			method := typed.ExplicitMethod(ind)
+			if !method.Exported() {
+				continue
+			}
+
+			ec.collect(fmt.Sprintf("%s.%s", name, method.Name()), method, method.Type())
+		}
+
+		for ind := 0; ind < typed.NumEmbeddeds(); ind++ {
+			ec.collect(name, object, typed.EmbeddedType(ind))
+		}
+	default:
+		ec.collect(name, object, underlying)
+	}
+}
+
+func (ec *exposedTypesCollector) collect(name string, object types.Object, goType types.Type) {
+	ec.visited = map[types.Type]struct{}{}
+	ec.walk(name, object, goType)
+}
+
+func (ec *exposedTypesCollector) walk(name string, object types.Object, goType types.Type) {
+	// aliases (type A = pkg.B) is resolved to actual type
+	goType = types.Unalias(goType)
+
+	if _, visited := ec.visited[goType]; visited {
+		return
+	}
+
+	ec.visited[goType] = struct{}{}
+
+	switch typed := goType.(type) {
+	case *types.Named:
+		typeObject := typed.Obj()
+		if typeObject.Pkg() != nil && typeObject.Pkg() != ec.pkg {
+			ec.found = append(ec.found, ExposedType{
+				Name:           name,
+				Definition:     ec.searcher.sourceFromObject(object),
+				TypeName:       fmt.Sprintf("%s.%s", typeObject.Pkg().Name(), typeObject.Name()),
+				TypeDefinition: ec.searcher.sourceFromObject(typeObject),
+			})
+		}
+
+		typeArgs := typed.TypeArgs()
+		for ind := 0; ind < typeArgs.Len(); ind++ {
+			ec.walk(name, object, typeArgs.At(ind))
+		}
+	case *types.Pointer:
+		ec.walk(name, object, typed.Elem())
+	case *types.Slice:
+		ec.walk(name, object, typed.Elem())
+	case *types.Array:
+		ec.walk(name, object, typed.Elem())
+	case *types.Chan:
+		ec.walk(name, object, typed.Elem())
+	case *types.Map:
+		ec.walk(name, object, typed.Key())
+		ec.walk(name, object, typed.Elem())
+	case *types.Signature:
+		ec.walk(name, object, typed.Params())
+		ec.walk(name, object, typed.Results())
+	case *types.Tuple:
+		for ind := 0; ind < typed.Len(); ind++ {
+			ec.walk(name, object, typed.At(ind).Type())
+		}
+	case *types.Struct:
+		for ind := 0; ind < typed.NumFields(); ind++ {
+			if typed.Field(ind).Exported() {
+				ec.walk(name, object, typed.Field(ind).Type())
+			}
+		}
+	case *types.Interface:
+		for ind := 0; ind < typed.NumMethods(); ind++ {
+			if typed.Method(ind).Exported() {
+				ec.walk(name, object, typed.Method(ind).Type())
+			}
+		}
+	}
+}
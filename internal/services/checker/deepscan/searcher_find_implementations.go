@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -127,6 +128,11 @@ func (s *Searcher) applyMethodImplementationsInPackages(method *InjectionMethod,
 				continue
 			}
 
+			if method.IsStruct {
+				s.findStructInjections(method, astPackage, astFile)
+				continue
+			}
+
 			s.findFunctionCalls(importAlias, method.Name, astFile, func(callExpr *ast.CallExpr) {
 				for gateIndex := range method.Gates {
 					gate := &method.Gates[gateIndex]
@@ -144,43 +150,7 @@ func (s *Searcher) applyMethodImplementationsInPackages(method *InjectionMethod,
 					}
 
 					for idx := gate.Index; idx <= maxIdx; idx++ {
-						callParam := callExpr.Args[idx]
-
-						paramType := astPackage.TypesInfo.TypeOf(callParam)
-						targetName, targetPos, valid := s.extractTargetFromCallParam(paramType)
-						if !valid {
-							// unknown injection type, possible generics or other not known
-							// go features on current moment
-							// we can extend this function later for new cases
-							continue
-						}
-
-						targetDefinitions := s.sourceFromToken(targetPos)
-						if targetDefinitions.Import == method.Definition.Import {
-							// injector use our public interface for typing
-							// we exclude this cases from more deep analyse, because of runtime
-							// types injection. (we have not known type on compile time)
-							continue
-						}
-
-						if !targetDefinitions.Place.Valid {
-							// invalid target
-							// possible is some not importable std const like `errors`
-							// or not known ast at this moment
-							continue
-						}
-
-						gate.Implementations = append(gate.Implementations, Implementation{
-							Injector: Injector{
-								CodeName:         s.extractCodeFromASTNode(callParam),
-								ParamDefinition:  s.sourceFromToken(callParam.Pos()),
-								MethodDefinition: s.sourceFromToken(callMethod.Pos()),
-							},
-							Target: Target{
-								StructName: targetName,
-								Definition: targetDefinitions,
-							},
-						})
+						s.applyImplementation(method, gate, astPackage, astFile, callExpr.Args[idx], callMethod.Pos())
 					}
 				}
 			})
@@ -188,6 +158,222 @@ func (s *Searcher) applyMethodImplementationsInPackages(method *InjectionMethod,
 	}
 }
 
+// find all struct literals and field assignments, who inject
+// something into struct gates, and apply it
+// example: `&a.Service{Repo: repo}` or `svc.Repo = repo`
+func (s *Searcher) findStructInjections(method *InjectionMethod, astPackage *packages.Package, astFile *ast.File) {
+	ast.Inspect(astFile, func(node ast.Node) bool {
+		switch expr := node.(type) {
+		case *ast.CompositeLit:
+			if !s.isInjectionStruct(method, astPackage.TypesInfo.TypeOf(expr)) {
+				return true
+			}
+
+			for elementIndex, element := range expr.Elts {
+				fieldName := ""
+				fieldValue := element
+
+				if keyValue, ok := element.(*ast.KeyValueExpr); ok {
+					key, ok := keyValue.Key.(*ast.Ident)
+					if !ok {
+						continue
+					}
+
+					fieldName = key.Name
+					fieldValue = keyValue.Value
+				}
+
+				for gateIndex := range method.Gates {
+					gate := &method.Gates[gateIndex]
+
+					if fieldName == "" && gate.Index != elementIndex {
+						// positional literal: `a.Service{repo, cache}`
+						continue
+					}
+
+					if fieldName != "" && gate.ParamName != fieldName {
+						continue
+					}
+
+					s.applyImplementation(method, gate, astPackage, astFile, fieldValue, expr.Pos())
+				}
+			}
+
+		case *ast.AssignStmt:
+			if len(expr.Lhs) != len(expr.Rhs) {
+				// multi value assign: `svc.Repo, err = provideRepo()`
+				// not have concrete type for injection
+				return true
+			}
+
+			for ind, lhs := range expr.Lhs {
+				selector, ok := lhs.(*ast.SelectorExpr)
+				if !ok {
+					continue
+				}
+
+				if !s.isInjectionStruct(method, astPackage.TypesInfo.TypeOf(selector.X)) {
+					continue
+				}
+
+				for gateIndex := range method.Gates {
+					gate := &method.Gates[gateIndex]
+					if gate.ParamName != selector.Sel.Name {
+						continue
+					}
+
+					s.applyImplementation(method, gate, astPackage, astFile, expr.Rhs[ind], selector.Pos())
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// isInjectionStruct check that type (or pointer to type) is struct
+// from injection method definition
+func (s *Searcher) isInjectionStruct(method *InjectionMethod, t types.Type) bool {
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == method.Definition.Import && named.Obj().Name() == method.Name
+}
+
+// resolve injected type from param expression, and append it
+// to gate implementations, when it is known project type
+func (s *Searcher) applyImplementation(
+	method *InjectionMethod,
+	gate *Gate,
+	astPackage *packages.Package,
+	astFile *ast.File,
+	param ast.Expr,
+	injectorPos token.Pos,
+) {
+	if gate.IsFunc {
+		s.applyFuncImplementation(method, gate, astPackage, param, injectorPos)
+		return
+	}
+
+	paramType := astPackage.TypesInfo.TypeOf(param)
+
+	if types.IsInterface(paramType) {
+		// param typed by interface, so concrete type is lost here
+		// try to find it from data flow (variables, factories, closures)
+		flowTypes := s.resolveFlowTypes(astPackage, astFile, param)
+		if len(flowTypes) > 0 {
+			for _, flowType := range flowTypes {
+				s.applyImplementationType(method, gate, flowType, param, injectorPos)
+			}
+
+			return
+		}
+	}
+
+	s.applyImplementationType(method, gate, paramType, param, injectorPos)
+}
+
+// applyFuncImplementation resolve function passed into function typed gate,
+// it can be package function (`handlers.Handle`) or method value (`svc.Handle`).
+// Anonymous functions is part of injector code, so they are skipped
+func (s *Searcher) applyFuncImplementation(
+	method *InjectionMethod,
+	gate *Gate,
+	astPackage *packages.Package,
+	param ast.Expr,
+	injectorPos token.Pos,
+) {
+	var funcObject types.Object
+
+	switch expr := astutil.Unparen(param).(type) {
+	case *ast.Ident:
+		funcObject = astPackage.TypesInfo.Uses[expr]
+	case *ast.SelectorExpr:
+		if selection, ok := astPackage.TypesInfo.Selections[expr]; ok {
+			funcObject = selection.Obj()
+			break
+		}
+
+		funcObject = astPackage.TypesInfo.Uses[expr.Sel]
+	}
+
+	fn, ok := funcObject.(*types.Func)
+	if !ok {
+		// function variables, literals, etc..
+		return
+	}
+
+	targetName := fn.Name()
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		recvObject, valid := s.extractTargetFromCallParam(recv.Type())
+		if valid {
+			targetName = fmt.Sprintf("%s.%s", recvObject.Name(), fn.Name())
+		}
+	}
+
+	s.appendImplementation(method, gate, targetName, fn, param, injectorPos)
+}
+
+func (s *Searcher) applyImplementationType(
+	method *InjectionMethod,
+	gate *Gate,
+	paramType types.Type,
+	param ast.Expr,
+	injectorPos token.Pos,
+) {
+	targetObject, valid := s.extractTargetFromCallParam(paramType)
+	if !valid {
+		// unknown injection type, possible generics or other not known
+		// go features on current moment
+		// we can extend this function later for new cases
+		return
+	}
+
+	s.appendImplementation(method, gate, targetObject.Name(), targetObject, param, injectorPos)
+}
+
+func (s *Searcher) appendImplementation(
+	method *InjectionMethod,
+	gate *Gate,
+	targetName string,
+	targetObject types.Object,
+	param ast.Expr,
+	injectorPos token.Pos,
+) {
+	targetDefinitions := s.sourceFromObject(targetObject)
+	if targetDefinitions.Import == method.Definition.Import {
+		// injector use our public interface for typing
+		// we exclude this cases from more deep analyse, because of runtime
+		// types injection. (we have not known type on compile time)
+		return
+	}
+
+	if !targetDefinitions.Place.Valid {
+		// invalid target
+		// possible is some not importable std const like `errors`
+		// or not known ast at this moment
+		return
+	}
+
+	gate.Implementations = append(gate.Implementations, Implementation{
+		Injector: Injector{
+			CodeName:         s.extractCodeFromASTNode(param),
+			ParamDefinition:  s.sourceFromToken(param.Pos()),
+			MethodDefinition: s.sourceFromToken(injectorPos),
+		},
+		Target: Target{
+			StructName: targetName,
+			Definition: targetDefinitions,
+		},
+	})
+}
+
 func (s *Searcher) extractCodeFromASTNode(node ast.Expr) string {
 	var buf bytes.Buffer
 	err := printer.Fprint(&buf, s.ctx.fileSet, node)
@@ -198,14 +384,14 @@ func (s *Searcher) extractCodeFromASTNode(node ast.Expr) string {
 	return "unknown"
 }
 
-func (s *Searcher) extractTargetFromCallParam(t types.Type) (name string, pos token.Pos, valid bool) {
+func (s *Searcher) extractTargetFromCallParam(t types.Type) (target *types.TypeName, valid bool) {
 	switch goType := t.(type) {
 	case *types.Named:
-		return goType.Obj().Name(), goType.Obj().Pos(), true
+		return goType.Obj(), true
 	case *types.Pointer:
 		return s.extractTargetFromCallParam(goType.Elem())
 	default:
-		return "", pos, false
+		return nil, false
 	}
 }
 
@@ -227,10 +413,20 @@ func (s *Searcher) findFunctionCalls(
 			return true
 		}
 
+		// explicit generic instantiation
+		// example: `slices.Max[int](..)` or `maps.Keys[string, int](..)`
+		callFunc := callExpr.Fun
+		switch indexExpr := callFunc.(type) {
+		case *ast.IndexExpr:
+			callFunc = indexExpr.X
+		case *ast.IndexListExpr:
+			callFunc = indexExpr.X
+		}
+
 		// check if this outside package function
 		// example: `fmt.Println(..)`
 		// Println is external function in fmt package
-		astFunc, ok := callExpr.Fun.(*ast.SelectorExpr)
+		astFunc, ok := callFunc.(*ast.SelectorExpr)
 		if !ok {
 			// not imported function
 			return true
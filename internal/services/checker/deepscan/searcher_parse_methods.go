@@ -28,6 +28,12 @@ func (s *Searcher) extractMethodsFromFile(astPackage *packages.Package, astFile
 	list := make([]InjectionMethod, 0)
 
 	for _, iDecl := range astFile.Decls {
+		if genDecl, ok := iDecl.(*ast.GenDecl); ok {
+			// structs with public interface fields, example: `type A struct { Repo myInterface }`
+			list = append(list, s.extractStructsFromDecl(astPackage, genDecl)...)
+			continue
+		}
+
 		decl, ok := iDecl.(*ast.FuncDecl)
 		if !ok {
 			// find only AST go methods, example: `func a()`
@@ -74,10 +80,15 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 			}
 
 			interfaceName, pos, isInterface := s.extractInterfaceName(paramType)
-			if !isInterface {
+			funcName, funcPos, isFunc := s.extractFuncName(paramType)
+			if !isInterface && !isFunc {
 				continue
 			}
 
+			if isFunc {
+				interfaceName, pos = funcName, funcPos
+			}
+
 			if !pos.IsValid() {
 				// invalid pos, its anonymous `interface{}`
 				// or some kind of this staff
@@ -101,6 +112,7 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 					GoType:     paramType.String(),
 				},
 				IsVariadic: isVariadic,
+				IsFunc:     isFunc,
 			})
 		}
 	}
@@ -108,6 +120,139 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 	return params
 }
 
+func (s *Searcher) extractStructsFromDecl(astPackage *packages.Package, decl *ast.GenDecl) []InjectionMethod {
+	list := make([]InjectionMethod, 0)
+
+	if decl.Tok != token.TYPE {
+		return list
+	}
+
+	for _, spec := range decl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		if !astIsPublicName(typeSpec.Name.Name) {
+			// private struct can`t be created outside
+			continue
+		}
+
+		if typeSpec.Assign.IsValid() || typeSpec.TypeParams != nil {
+			// skip aliases (`type A = B`) and generic structs
+			continue
+		}
+
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		gates := s.extractStructGates(astPackage, typeSpec, structType)
+		if len(gates) == 0 {
+			continue
+		}
+
+		list = append(list, InjectionMethod{
+			Name:       typeSpec.Name.Name,
+			Definition: s.sourceFromToken(typeSpec.Name.Pos()),
+			Gates:      gates,
+			IsStruct:   true,
+		})
+	}
+
+	return list
+}
+
+func (s *Searcher) extractStructGates(astPackage *packages.Package, typeSpec *ast.TypeSpec, structType *ast.StructType) []Gate {
+	fields := structType.Fields.List
+	gates := make([]Gate, 0, len(fields))
+	fieldIndex := -1
+
+	for _, field := range fields {
+		fieldType := astPackage.TypesInfo.TypeOf(field.Type)
+
+		for _, fieldName := range s.structFieldNames(field, fieldType) {
+			fieldIndex++
+
+			if !astIsPublicName(fieldName) {
+				// private field can be set only inside
+				// struct package, it`s not a gate
+				continue
+			}
+
+			interfaceName, pos, isInterface := s.extractInterfaceName(fieldType)
+			if !isInterface {
+				continue
+			}
+
+			if !pos.IsValid() {
+				pos = field.Pos()
+			}
+
+			gates = append(gates, Gate{
+				MethodName:         typeSpec.Name.Name,
+				ParamName:          fieldName,
+				Index:              fieldIndex,
+				MethodDefinition:   s.sourceFromToken(typeSpec.Pos()),
+				ArgumentDefinition: s.sourceFromToken(field.Pos()),
+				Interface: Interface{
+					Name:       interfaceName,
+					Definition: s.sourceFromToken(pos),
+					GoType:     fieldType.String(),
+				},
+				IsField: true,
+			})
+		}
+	}
+
+	return gates
+}
+
+// structFieldNames return names of all fields in declaration,
+// embedded field (`type A struct { Repository }`) is named by its type
+func (s *Searcher) structFieldNames(field *ast.Field, fieldType types.Type) []string {
+	if len(field.Names) > 0 {
+		names := make([]string, 0, len(field.Names))
+		for _, ident := range field.Names {
+			names = append(names, ident.Name)
+		}
+
+		return names
+	}
+
+	if named, ok := fieldType.(*types.Named); ok {
+		return []string{named.Obj().Name()}
+	}
+
+	// embedded pointer, it`s not interface anyway
+	return []string{"*"}
+}
+
+// extractFuncName check that param is function, so any
+// function can be injected into: `func(handler func(ctx) error)`
+func (s *Searcher) extractFuncName(t types.Type) (name string, ref token.Pos, isFunc bool) {
+	switch goType := t.(type) {
+	case *types.Signature:
+		return t.String(), ref, true
+
+	// named func type: `type handler func(ctx) error`
+	case *types.Named:
+		if _, isSignature := goType.Underlying().(*types.Signature); !isSignature {
+			return "", ref, false
+		}
+
+		return goType.Obj().Name(), goType.Obj().Pos(), true
+
+	// `func(handlers []handler)` or variadic `func(handlers ...handler)`
+	case *types.Slice:
+		return s.extractFuncName(goType.Elem())
+
+	default:
+		return "", ref, false
+	}
+}
+
 func (s *Searcher) extractInterfaceName(t types.Type) (name string, ref token.Pos, isInterface bool) {
 	switch goType := t.(type) {
 	// anon interfaces: `func(a interface{})`
@@ -122,6 +267,11 @@ func (s *Searcher) extractInterfaceName(t types.Type) (name string, ref token.Po
 
 		return goType.Obj().Name(), goType.Obj().Pos(), true
 
+	// type param: `func[T myInterface](a T)`, implementation
+	// is any type, who satisfy constraint interface
+	case *types.TypeParam:
+		return s.extractInterfaceName(goType.Constraint())
+
 	// pointer to type: `func(a *int)`, possible can point to interface
 	// but is useless in real code, so always skip this params
 	case *types.Pointer:
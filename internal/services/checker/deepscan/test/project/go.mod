@@ -1,2 +1,2 @@
-module github.com/fe3dback/go-arch-lint/internal/glue/deepscan/test/project
+module github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project
 go 1.18
@@ -0,0 +1,39 @@
+package di
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project/internal/operations"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project/internal/repository"
+)
+
+func TestCasesGenericsAndFuncs() {
+	t2c1GenericPass()
+	t2c2GenericExplicitPass()
+	t2c3FuncPass()
+	t2c4MethodValuePass()
+}
+
+func t2c1GenericPass() {
+	operations.VisibleGenericConstraint8(repository.NewMemory())
+	operations.VisibleGenericAnonConstraint8(repository.NewMemory())
+	operations.VisibleGenericSlice8([]*repository.Memory{repository.NewMemory()})
+}
+
+func t2c2GenericExplicitPass() {
+	operations.VisibleGenericConstraint8[*repository.Memory](repository.NewMemory())
+}
+
+func t2c3FuncPass() {
+	operations.VisibleFuncParam9(repository.Handle)
+	operations.VisibleNamedFuncParam9(repository.Handle)
+	operations.VisibleFuncParamSpread9(repository.Handle, func(ctx context.Context) error {
+		// anonymous functions is part of injector code
+		return nil
+	})
+}
+
+func t2c4MethodValuePass() {
+	memory := repository.NewMemory()
+	operations.VisibleFuncParam9(memory.Handle)
+}
@@ -0,0 +1,32 @@
+package exposed
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project/internal/repository"
+)
+
+type Service struct {
+	Repo  *repository.Memory
+	cache map[string]repository.Memory
+}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+func (s *Service) All(_ context.Context) []*repository.Memory {
+	return nil
+}
+
+func (s *Service) first() repository.Memory {
+	return repository.Memory{}
+}
+
+type Storage = repository.Memory
+
+type storage = repository.Memory
+
+func DefaultStorage() *storage {
+	return &storage{}
+}
@@ -1,6 +1,6 @@
 package operations
 
-import "github.com/fe3dback/go-arch-lint/internal/pkg/deepscan/test/project/internal/shared"
+import "github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project/internal/shared"
 
 func SharedVisible6(s shared.Repository) {
 
@@ -0,0 +1,30 @@
+package operations
+
+type (
+	genericFetcher interface {
+		Fetch()
+	}
+
+	genericProcessor8[T any] struct {
+		fetcher T
+	}
+)
+
+func VisibleGenericConstraint8[F genericFetcher](fetcher F) *genericProcessor8[F] {
+	// visible: type param constrained by interface
+	return &genericProcessor8[F]{fetcher: fetcher}
+}
+
+func VisibleGenericAnonConstraint8[F interface{ Fetch() }](fetcher F) *genericProcessor8[F] {
+	// visible: type param constrained by anonymous interface
+	return &genericProcessor8[F]{fetcher: fetcher}
+}
+
+func VisibleGenericSlice8[F genericFetcher](fetchers []F) *genericProcessor8[F] {
+	// visible: slice of type param
+	return &genericProcessor8[F]{fetcher: fetchers[0]}
+}
+
+func InvisibleGenericUnnamed8[F genericFetcher](_ F) {
+	// invisible: placeholder param name
+}
@@ -0,0 +1,28 @@
+package operations
+
+import "context"
+
+type (
+	funcHandler9 func(ctx context.Context) error
+)
+
+func VisibleFuncParam9(handler func(ctx context.Context) error) {
+	// visible: function typed param
+	_ = handler(context.Background())
+}
+
+func VisibleNamedFuncParam9(handler funcHandler9) {
+	// visible: named function type param
+	_ = handler(context.Background())
+}
+
+func VisibleFuncParamSpread9(handlers ...funcHandler9) {
+	// visible: variadic function typed param
+	for _, handler := range handlers {
+		_ = handler(context.Background())
+	}
+}
+
+func InvisibleFuncParamUnnamed9(_ func()) {
+	// invisible: placeholder param name
+}
@@ -1,5 +1,7 @@
 package repository
 
+import "context"
+
 type Memory struct {
 }
 
@@ -10,3 +12,11 @@ func NewMemory() *Memory {
 func (m Memory) Fetch() {
 	panic("implement me")
 }
+
+func (m Memory) Handle(_ context.Context) error {
+	return nil
+}
+
+func Handle(_ context.Context) error {
+	return nil
+}
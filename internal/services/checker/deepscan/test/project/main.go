@@ -1,7 +1,8 @@
 package main
 
-import "github.com/fe3dback/go-arch-lint/internal/pkg/deepscan/test/project/internal/di"
+import "github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan/test/project/internal/di"
 
 func main() {
 	di.TestCases()
+	di.TestCasesGenericsAndFuncs()
 }
@@ -45,3 +45,30 @@ func test1Expected() []deepscan2.InjectionMethod {
 		},
 	}
 }
+
+func TestExposedTypes(t *testing.T) {
+	_, callerDir, _, _ := runtime.Caller(0)
+	projectDir := filepath.Join(filepath.Dir(callerDir), "project")
+
+	searcher := deepscan2.NewSearcher()
+	criteria, err := deepscan2.NewCriteria(
+		deepscan2.WithPackagePath(filepath.Join(projectDir, "internal", "exposed")),
+	)
+	assert.NoError(t, err)
+
+	exposed, err := searcher.ExposedTypes(criteria)
+	assert.NoError(t, err)
+
+	actual := make([]string, 0, len(exposed))
+	for _, exposedType := range exposed {
+		actual = append(actual, fmt.Sprintf("%s: %s:%d", exposedType.Name, exposedType.TypeName, exposedType.Definition.Place.Line))
+	}
+
+	assert.Equal(t, []string{
+		"DefaultStorage: repository.Memory:30",
+		"Service.Repo: repository.Memory:10",
+		"Service.All: context.Context:18",
+		"Service.All: repository.Memory:18",
+		"Storage: repository.Memory:26",
+	}, actual)
+}
@@ -14,6 +14,7 @@ func newResults() results {
 	return results{
 		DependencyWarnings: []models.CheckArchWarningDependency{},
 		MatchWarnings:      []models.CheckArchWarningMatch{},
+		ModuleWarnings:     []models.CheckArchWarningModule{},
 	}
 }
 
@@ -25,6 +26,10 @@ func (res *results) addDependencyWarning(warn models.CheckArchWarningDependency)
 	res.DependencyWarnings = append(res.DependencyWarnings, warn)
 }
 
+func (res *results) addModuleWarning(warn models.CheckArchWarningModule) {
+	res.ModuleWarnings = append(res.ModuleWarnings, warn)
+}
+
 func (res *results) assembleSortedResults() models.CheckResult {
 	sort.Slice(res.DependencyWarnings, func(i, j int) bool {
 		return res.DependencyWarnings[i].FileRelativePath < res.DependencyWarnings[j].FileRelativePath
@@ -34,8 +39,13 @@ func (res *results) assembleSortedResults() models.CheckResult {
 		return res.MatchWarnings[i].FileRelativePath < res.MatchWarnings[j].FileRelativePath
 	})
 
+	sort.Slice(res.ModuleWarnings, func(i, j int) bool {
+		return res.ModuleWarnings[i].Reference.Line < res.ModuleWarnings[j].Reference.Line
+	})
+
 	return models.CheckResult{
 		DependencyWarnings: res.DependencyWarnings,
 		MatchWarnings:      res.MatchWarnings,
+		ModuleWarnings:     res.ModuleWarnings,
 	}
 }
@@ -14,6 +14,7 @@ type (
 	}
 
 	checker interface {
+		Name() string
 		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
 	}
 
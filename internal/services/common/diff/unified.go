@@ -0,0 +1,209 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	Differ struct {
+		contextLines int
+	}
+
+	opKind int
+
+	operation struct {
+		kind opKind
+		line string
+	}
+)
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+func NewDiffer(contextLines int) *Differ {
+	return &Differ{
+		contextLines: contextLines,
+	}
+}
+
+// Unified return unified diff (like `diff -u`) between two texts,
+// or empty string, when texts is equal
+func (d *Differ) Unified(fromName, toName string, from, to []byte) string {
+	if string(from) == string(to) {
+		return ""
+	}
+
+	ops := lineOperations(splitLines(string(from)), splitLines(string(to)))
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName))
+
+	for _, hunk := range d.hunks(ops) {
+		out.WriteString(hunk)
+	}
+
+	return out.String()
+}
+
+// hunks group operations into hunks with context lines around changes
+func (d *Differ) hunks(ops []operation) []string {
+	hunks := make([]string, 0)
+
+	for start := 0; start < len(ops); {
+		// find next change
+		for start < len(ops) && ops[start].kind == opEqual {
+			start++
+		}
+
+		if start >= len(ops) {
+			break
+		}
+
+		// extend hunk, while changes is closer than 2*context lines
+		end := start
+		for ind := start; ind < len(ops); ind++ {
+			if ops[ind].kind != opEqual {
+				end = ind
+				continue
+			}
+
+			if ind-end > 2*d.contextLines {
+				break
+			}
+		}
+
+		from := maxInt(0, start-d.contextLines)
+		to := minInt(len(ops), end+d.contextLines+1)
+
+		hunks = append(hunks, d.renderHunk(ops, from, to))
+		start = to
+	}
+
+	return hunks
+}
+
+func (d *Differ) renderHunk(ops []operation, from, to int) string {
+	// line numbers before hunk
+	fromLine, toLine := 1, 1
+	for _, op := range ops[:from] {
+		if op.kind != opInsert {
+			fromLine++
+		}
+
+		if op.kind != opDelete {
+			toLine++
+		}
+	}
+
+	fromCount, toCount := 0, 0
+	var body strings.Builder
+
+	for _, op := range ops[from:to] {
+		switch op.kind {
+		case opEqual:
+			fromCount++
+			toCount++
+			body.WriteString(" " + op.line + "\n")
+		case opDelete:
+			fromCount++
+			body.WriteString("-" + op.line + "\n")
+		case opInsert:
+			toCount++
+			body.WriteString("+" + op.line + "\n")
+		}
+	}
+
+	return fmt.Sprintf("@@ -%s +%s @@\n%s",
+		hunkRange(fromLine, fromCount),
+		hunkRange(toLine, toCount),
+		body.String(),
+	)
+}
+
+// lineOperations find longest common subsequence of lines,
+// arch files is small, so simple O(n*m) algorithm is enough
+func lineOperations(from, to []string) []operation {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]operation, 0, len(from)+len(to))
+	i, j := 0, 0
+
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			ops = append(ops, operation{kind: opEqual, line: from[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, operation{kind: opDelete, line: from[i]})
+			i++
+		default:
+			ops = append(ops, operation{kind: opInsert, line: to[j]})
+			j++
+		}
+	}
+
+	for ; i < len(from); i++ {
+		ops = append(ops, operation{kind: opDelete, line: from[i]})
+	}
+
+	for ; j < len(to); j++ {
+		ops = append(ops, operation{kind: opInsert, line: to[j]})
+	}
+
+	return ops
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+func hunkRange(line, count int) string {
+	if count == 0 {
+		// empty range is pointed to line before
+		return fmt.Sprintf("%d,0", line-1)
+	}
+
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,182 @@
+package toml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2/unstable"
+)
+
+type (
+	// path is location of value in document, table keys
+	// is quoted, array indexes is not (a."b".0)
+	path string
+
+	// locations is original toml positions of every
+	// value in document (start of value), by value path
+	locations map[path]Position
+
+	locator struct {
+		parser      *unstable.Parser
+		locations   locations
+		arrayTables map[path]int // count of [[table]] headers by path
+	}
+)
+
+func (p path) key(name string) path {
+	return p.join(strconv.Quote(name))
+}
+
+func (p path) index(ind int) path {
+	return p.join(strconv.Itoa(ind))
+}
+
+func (p path) join(part string) path {
+	if p == "" {
+		return path(part)
+	}
+
+	return path(string(p) + "." + part)
+}
+
+// locate will find original positions of all keys and values
+// in toml document. Library decoder not expose this information,
+// so document is parsed again with low level toml parser
+func locate(source []byte) (locations, error) {
+	l := &locator{
+		parser:      &unstable.Parser{},
+		locations:   locations{"": {Line: 1, Column: 1}},
+		arrayTables: map[path]int{},
+	}
+
+	l.parser.Reset(source)
+
+	table := path("")
+	for l.parser.NextExpression() {
+		expr := l.parser.Expression()
+
+		switch expr.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			table = l.locateTable(expr)
+		case unstable.KeyValue:
+			l.locateKeyValue(table, expr)
+		}
+	}
+
+	if err := l.parser.Error(); err != nil {
+		return nil, fmt.Errorf("failed parse document: %w", err)
+	}
+
+	return l.locations, nil
+}
+
+// locateTable return path of table, defined by [header] or [[header]]
+func (l *locator) locateTable(expr *unstable.Node) path {
+	isArrayTable := expr.Kind == unstable.ArrayTable
+	table := path("")
+
+	it := expr.Key()
+	for it.Next() {
+		key := it.Node()
+		pos := l.position(key, Position{})
+
+		table = table.key(string(key.Data))
+		l.locations.set(table, pos)
+
+		if isArrayTable && it.IsLast() {
+			ind := l.arrayTables[table]
+			l.arrayTables[table]++
+
+			table = table.index(ind)
+			l.locations.set(table, pos)
+			continue
+		}
+
+		// nested table of last [[header]] element
+		if count, exist := l.arrayTables[table]; exist {
+			table = table.index(count - 1)
+		}
+	}
+
+	return table
+}
+
+func (l *locator) locateKeyValue(table path, expr *unstable.Node) {
+	current := table
+	var lastKey *unstable.Node
+
+	it := expr.Key()
+	for it.Next() {
+		lastKey = it.Node()
+		pos := l.position(lastKey, Position{})
+
+		current = current.key(string(lastKey.Data))
+		l.locations.set(current, pos) // implicit tables of dotted keys
+	}
+
+	if lastKey == nil {
+		return
+	}
+
+	l.locateValue(current, expr.Value(), l.valueStart(lastKey))
+}
+
+func (l *locator) locateValue(at path, value *unstable.Node, pos Position) {
+	l.locations[at] = pos
+
+	switch value.Kind {
+	case unstable.Array:
+		ind := 0
+		it := value.Children()
+		for it.Next() {
+			child := it.Node()
+			l.locateValue(at.index(ind), child, l.position(child, pos))
+			ind++
+		}
+	case unstable.InlineTable:
+		it := value.Children()
+		for it.Next() {
+			l.locateKeyValue(at, it.Node())
+		}
+	}
+}
+
+// valueStart return position of first value char after key (key = value).
+// Not all value nodes (arrays, booleans) contain raw range
+func (l *locator) valueStart(key *unstable.Node) Position {
+	data := l.parser.Data()
+	offset := int(key.Raw.Offset + key.Raw.Length)
+
+	for offset < len(data)-1 && strings.ContainsRune(" \t=", rune(data[offset])) {
+		offset++
+	}
+
+	return l.shape(unstable.Range{Offset: uint32(offset), Length: 1})
+}
+
+func (l *locator) position(node *unstable.Node, fallback Position) Position {
+	switch {
+	case node.Raw.Length > 0:
+		return l.shape(node.Raw)
+	case len(node.Data) > 0:
+		return l.shape(l.parser.Range(node.Data))
+	}
+
+	return fallback
+}
+
+func (l *locator) shape(r unstable.Range) Position {
+	start := l.parser.Shape(r).Start
+
+	return Position{
+		Line:   start.Line,
+		Column: start.Column,
+	}
+}
+
+func (l locations) set(at path, pos Position) {
+	if _, exist := l[at]; !exist {
+		l[at] = pos
+	}
+}
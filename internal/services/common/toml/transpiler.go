@@ -0,0 +1,74 @@
+package toml
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+type (
+	// Position is line and column (both from 1) in toml source
+	Position struct {
+		Line   int
+		Column int
+	}
+
+	// Positions is map of transpiled document line to
+	// original toml position of key or value on this line
+	Positions map[int]Position
+)
+
+// Transpile will convert toml document into equivalent json document,
+// that is also valid yaml, so it can be decoded by any yaml decoder.
+//
+// Every key and scalar value of document is placed on separated line,
+// positions contain original toml position for each of this lines.
+// Document is decoded by toml library, dates and special floats
+// (inf, nan) is transpiled into strings
+func Transpile(source []byte) ([]byte, Positions, error) {
+	var document map[string]any
+
+	err := toml.Unmarshal(source, &document)
+	if err != nil {
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			line, column := decodeErr.Position()
+			return nil, nil, fmt.Errorf("toml: line %d column %d: %s", line, column,
+				strings.TrimPrefix(decodeErr.Error(), "toml: "),
+			)
+		}
+
+		return nil, nil, fmt.Errorf("toml: %w", err)
+	}
+
+	locations, err := locate(source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("toml: %w", err)
+	}
+
+	w := &writer{
+		locations: locations,
+		positions: Positions{},
+		line:      1,
+	}
+
+	err = w.writeValue(document, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("toml: %w", err)
+	}
+
+	w.buff.WriteString("\n")
+	return w.buff.Bytes(), w.positions, nil
+}
+
+// Translate return original toml line and column of transpiled
+// document position. Unknown positions is returned as is
+func (p Positions) Translate(line int, column int) (int, int) {
+	if pos, exist := p[line]; exist {
+		return pos.Line, pos.Column
+	}
+
+	return line, column
+}
@@ -0,0 +1,125 @@
+package toml
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranspile(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "scalars",
+			source: "version = 3\nworkdir = 'internal' # comment\nflag = true\nratio = 1_000.5\nhex = 0xFF\n",
+			want:   `{"version":3,"workdir":"internal","flag":true,"ratio":1000.5,"hex":255}`,
+		},
+		{
+			name:   "strings",
+			source: "a = \"tab\\tquote\\\" \\u00e9\"\nb = '''\nraw \\n'''\nc = \"\"\"\nline \\\n   joined\"\"\"\n",
+			want:   `{"a":"tab\tquote\" é","b":"raw \\n","c":"line joined"}`,
+		},
+		{
+			name:   "tables",
+			source: "[allow]\ndeepScan = false\n\n[components.api]\nin = [\"api/**\", \"pkg/api\"]\n\n[components.\"{svc}-app\"]\nin = \"services/{svc}/app\"\n",
+			want:   `{"allow":{"deepScan":false},"components":{"api":{"in":["api/**","pkg/api"]},"{svc}-app":{"in":"services/{svc}/app"}}}`,
+		},
+		{
+			name:   "dotted keys and inline tables",
+			source: "vendors.cobra = { in = \"github.com/spf13/cobra\" }\ndeps = { api = { mayDependOn = [\n  \"models\", # trailing comma\n] } }\n",
+			want:   `{"vendors":{"cobra":{"in":"github.com/spf13/cobra"}},"deps":{"api":{"mayDependOn":["models"]}}}`,
+		},
+		{
+			name:   "dates and special floats",
+			source: "local = 1979-05-27 07:32:00\nzoned = 1979-05-27T07:32:00Z\nday = 1979-05-27\nbig = inf\nnone = nan\n",
+			want:   `{"local":"1979-05-27T07:32:00","zoned":"1979-05-27T07:32:00Z","day":"1979-05-27","big":"inf","none":"nan"}`,
+		},
+		{
+			name:   "array of tables",
+			source: "[[items]]\nname = \"a\"\n\n[[items]]\nname = \"b\"\n",
+			want:   `{"items":[{"name":"a"},{"name":"b"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := Transpile([]byte(tt.source))
+			require.NoError(t, err)
+
+			var gotValue, wantValue any
+			require.NoError(t, json.Unmarshal(got, &gotValue), string(got))
+			require.NoError(t, json.Unmarshal([]byte(tt.want), &wantValue))
+			assert.Equal(t, wantValue, gotValue)
+		})
+	}
+}
+
+func TestTranspileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{name: "duplicate key", source: "a = 1\na = 2\n"},
+		{name: "duplicate table", source: "[a]\n[a]\n"},
+		{name: "inline table extended", source: "a = { b = 1 }\n[a]\n"},
+		{name: "no value", source: "a =\n"},
+		{name: "two values on line", source: "a = 1 b = 2\n"},
+		{name: "unterminated string", source: "a = \"abc\n"},
+		{name: "inline table in array extended", source: "a = [{ b = 1 }]\n[[a]]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Transpile([]byte(tt.source))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTranspilePositions(t *testing.T) {
+	source := "version = 3\n\n[components]\napi = { in = \"api\" }\n\n[deps.api]\nmayDependOn = [\n  \"models\",\n]\n\n[[items]]\nname = true\n"
+
+	got, positions, err := Transpile([]byte(source))
+	require.NoError(t, err)
+
+	// {
+	// "components": {
+	// "api": {
+	// "in": "api"
+	// }
+	// },
+	// "deps": {
+	// "api": {
+	// "mayDependOn": [
+	// "models"
+	// ]
+	// }
+	// },
+	// "items": [
+	// {
+	// "name": true
+	// }
+	// ],
+	// "version": 3
+	// }
+	assert.Equal(t, Position{Line: 3, Column: 2}, positions[2], string(got))
+	assert.Equal(t, Position{Line: 4, Column: 7}, positions[3])
+	assert.Equal(t, Position{Line: 4, Column: 14}, positions[4])
+	assert.Equal(t, Position{Line: 6, Column: 2}, positions[7])
+	assert.Equal(t, Position{Line: 6, Column: 7}, positions[8])
+	assert.Equal(t, Position{Line: 7, Column: 15}, positions[9])
+	assert.Equal(t, Position{Line: 8, Column: 3}, positions[10])
+	assert.Equal(t, Position{Line: 11, Column: 3}, positions[14])
+	assert.Equal(t, Position{Line: 11, Column: 3}, positions[15])
+	assert.Equal(t, Position{Line: 12, Column: 8}, positions[16])
+	assert.Equal(t, Position{Line: 1, Column: 11}, positions[19])
+
+	line, column := positions.Translate(10, 1)
+	assert.Equal(t, 8, line)
+	assert.Equal(t, 3, column)
+}
@@ -0,0 +1,123 @@
+package toml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+type writer struct {
+	buff      bytes.Buffer
+	locations locations
+	positions Positions
+	line      int
+}
+
+// writeValue will write json representation of decoded value, every
+// key and scalar is written on separated line
+func (w *writer) writeValue(value any, at path) error {
+	w.mark(w.locations[at])
+
+	switch typed := value.(type) {
+	case []any:
+		w.buff.WriteString("[")
+
+		for ind, item := range typed {
+			w.newLine()
+
+			err := w.writeValue(item, at.index(ind))
+			if err != nil {
+				return err
+			}
+
+			if ind < len(typed)-1 {
+				w.buff.WriteString(",")
+			}
+		}
+
+		w.newLine()
+		w.buff.WriteString("]")
+		return nil
+
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+		w.buff.WriteString("{")
+
+		for ind, key := range keys {
+			w.newLine()
+
+			encodedKey, err := json.Marshal(key)
+			if err != nil {
+				return fmt.Errorf("failed encode key '%s': %w", key, err)
+			}
+
+			child := at.key(key)
+			w.mark(w.locations[child])
+			w.buff.Write(encodedKey)
+			w.buff.WriteString(": ")
+
+			err = w.writeValue(typed[key], child)
+			if err != nil {
+				return err
+			}
+
+			if ind < len(keys)-1 {
+				w.buff.WriteString(",")
+			}
+		}
+
+		w.newLine()
+		w.buff.WriteString("}")
+		return nil
+	}
+
+	encoded, err := marshalScalar(value)
+	if err != nil {
+		return fmt.Errorf("failed encode value '%v': %w", value, err)
+	}
+
+	w.buff.Write(encoded)
+	return nil
+}
+
+// marshalScalar encode toml scalar into json. Special
+// floats not exist in json, so they encoded as strings,
+// dates is encoded by json marshaller as strings
+func marshalScalar(value any) ([]byte, error) {
+	if float, ok := value.(float64); ok {
+		switch {
+		case math.IsNaN(float):
+			return json.Marshal("nan")
+		case math.IsInf(float, 1):
+			return json.Marshal("inf")
+		case math.IsInf(float, -1):
+			return json.Marshal("-inf")
+		}
+	}
+
+	return json.Marshal(value)
+}
+
+func (w *writer) mark(pos Position) {
+	if pos.Line == 0 {
+		return
+	}
+
+	if _, exist := w.positions[w.line]; exist {
+		return
+	}
+
+	w.positions[w.line] = pos
+}
+
+func (w *writer) newLine() {
+	w.buff.WriteString("\n")
+	w.line++
+}
@@ -0,0 +1,97 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+type (
+	// Constraint is list of semver conditions, all of them
+	// should match to version, example: ">=1.4 <2", "!=v1.5.2"
+	Constraint struct {
+		conditions []condition
+	}
+
+	condition struct {
+		operator string
+		version  string
+	}
+)
+
+// operators is ordered, longest operators should be checked first
+var operators = []string{">=", "<=", "!=", ">", "<", "="}
+
+func ParseConstraint(constraint string) (Constraint, error) {
+	fields := strings.Fields(strings.ReplaceAll(constraint, ",", " "))
+	if len(fields) == 0 {
+		return Constraint{}, fmt.Errorf("constraint is empty")
+	}
+
+	conditions := make([]condition, 0, len(fields))
+	for _, field := range fields {
+		operator := "="
+		for _, knownOperator := range operators {
+			if strings.HasPrefix(field, knownOperator) {
+				operator = knownOperator
+				break
+			}
+		}
+
+		version := normalize(strings.TrimPrefix(field, operator))
+		if !semver.IsValid(version) {
+			return Constraint{}, fmt.Errorf("invalid semver version '%s'", strings.TrimPrefix(field, operator))
+		}
+
+		conditions = append(conditions, condition{
+			operator: operator,
+			version:  version,
+		})
+	}
+
+	return Constraint{conditions: conditions}, nil
+}
+
+// Match check that version (like v1.4.2) satisfies all constraint conditions
+func (c Constraint) Match(version string) bool {
+	version = normalize(version)
+	if !semver.IsValid(version) {
+		return false
+	}
+
+	for _, cond := range c.conditions {
+		if !cond.match(version) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c condition) match(version string) bool {
+	cmp := semver.Compare(version, c.version)
+
+	switch c.operator {
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp == 0
+	}
+}
+
+func normalize(version string) string {
+	if !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+
+	return version
+}
@@ -0,0 +1,41 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraint_Match(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: ">=1.4", version: "v1.4.0", want: true},
+		{constraint: ">=1.4", version: "v1.3.9", want: false},
+		{constraint: ">=1.4 <2", version: "v1.9.1", want: true},
+		{constraint: ">=1.4, <2", version: "v2.0.0+incompatible", want: false},
+		{constraint: "<v0.9", version: "v0.8.1", want: true},
+		{constraint: "!=1.5.2", version: "v1.5.2", want: false},
+		{constraint: "1.5.2", version: "v1.5.2", want: true},
+		{constraint: ">1.0.0", version: "v1.0.1-0.20230101000000-abcdefabcdef", want: true},
+		{constraint: ">=1.0.0", version: "invalid", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			constraint, err := ParseConstraint(tt.constraint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, constraint.Match(tt.version))
+		})
+	}
+}
+
+func TestParseConstraint_invalid(t *testing.T) {
+	for _, constraint := range []string{"", ">=", ">=abc", "~1.4"} {
+		_, err := ParseConstraint(constraint)
+		assert.Error(t, err, constraint)
+	}
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"oss.terrastruct.com/d2/d2graph"
+	"oss.terrastruct.com/d2/d2layouts/d2dagrelayout"
+	"oss.terrastruct.com/d2/d2layouts/d2elklayout"
+	"oss.terrastruct.com/d2/d2lib"
+	"oss.terrastruct.com/d2/d2renderers/d2svg"
+	"oss.terrastruct.com/d2/d2target"
+	"oss.terrastruct.com/d2/d2themes"
+	"oss.terrastruct.com/d2/d2themes/d2themescatalog"
+	"oss.terrastruct.com/d2/lib/textmeasure"
+)
+
+// Compile d2 code into image in opts.Format (svg, png or pdf)
+func (r *Renderer) Compile(ctx context.Context, graphCode []byte, opts models.CmdGraphIn) ([]byte, error) {
+	theme, err := r.findTheme(opts.Theme)
+	if err != nil {
+		return nil, err
+	}
+
+	diagram, err := r.compileDiagram(ctx, graphCode, opts.Layout)
+	if err != nil {
+		return nil, err
+	}
+
+	switch opts.Format {
+	case models.GraphFormatSVG:
+		out, err := d2svg.Render(diagram, &d2svg.RenderOpts{
+			Pad:     opts.Padding,
+			Sketch:  opts.Sketch,
+			ThemeID: theme.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("svg render failed: %w", err)
+		}
+
+		return out, nil
+	case models.GraphFormatPNG:
+		out, err := renderPNG(diagram, theme, opts.Padding, opts.Sketch)
+		if err != nil {
+			return nil, fmt.Errorf("png render failed: %w", err)
+		}
+
+		return out, nil
+	case models.GraphFormatPDF:
+		out, err := renderPDF(diagram, theme, opts.Padding, opts.Sketch)
+		if err != nil {
+			return nil, fmt.Errorf("pdf render failed: %w", err)
+		}
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("format '%s' is not image", opts.Format)
+	}
+}
+
+func (r *Renderer) compileDiagram(ctx context.Context, graphCode []byte, layout models.GraphLayout) (*d2target.Diagram, error) {
+	ruler, err := textmeasure.NewRuler()
+	if err != nil {
+		return nil, fmt.Errorf("failed create ruler: %w", err)
+	}
+
+	diagram, _, err := d2lib.Compile(ctx, string(graphCode), &d2lib.CompileOptions{
+		Layout: func(ctx context.Context, g *d2graph.Graph) error {
+			if layout == models.GraphLayoutELK {
+				return d2elklayout.Layout(ctx, g, nil)
+			}
+
+			return d2dagrelayout.Layout(ctx, g, nil)
+		},
+		Ruler: ruler,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed compile d2 graph: %w", err)
+	}
+
+	return diagram, nil
+}
+
+// findTheme will find d2 theme by ID or by name slug
+// (lower case name with dashes, like "neutral-default")
+func (r *Renderer) findTheme(name string) (d2themes.Theme, error) {
+	themes := make([]d2themes.Theme, 0, len(d2themescatalog.LightCatalog)+len(d2themescatalog.DarkCatalog))
+	themes = append(themes, d2themescatalog.LightCatalog...)
+	themes = append(themes, d2themescatalog.DarkCatalog...)
+
+	slugs := make([]string, 0, len(themes))
+
+	for _, theme := range themes {
+		slug := themeSlug(theme)
+		slugs = append(slugs, slug)
+
+		if slug == strings.ToLower(name) || strconv.FormatInt(theme.ID, 10) == name {
+			return theme, nil
+		}
+	}
+
+	return d2themes.Theme{}, fmt.Errorf("unknown theme '%s', available: [%s]",
+		name,
+		strings.Join(slugs, ", "),
+	)
+}
+
+func themeSlug(theme d2themes.Theme) string {
+	return strings.ReplaceAll(strings.ToLower(theme.Name), " ", "-")
+}
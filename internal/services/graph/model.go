@@ -0,0 +1,82 @@
+package graph
+
+import "github.com/fe3dback/go-arch-lint/internal/models"
+
+const (
+	nodeKindComponent nodeKind = "component"
+	nodeKindVendor    nodeKind = "vendor"
+)
+
+const (
+	edgeKindDependency edgeKind = "dependency"
+	edgeKindVendor     edgeKind = "vendor"
+)
+
+const (
+	vendorFontSize = 12
+	vendorColor    = "#77AA44"
+)
+
+type (
+	nodeKind = string
+	edgeKind = string
+
+	// graphModel is format-neutral representation of
+	// architecture graph, every output format is rendered from it
+	graphModel struct {
+		Type  models.GraphType
+		Nodes []graphNode
+		Edges []graphEdge
+	}
+
+	graphNode struct {
+		Name  string
+		Kind  nodeKind
+		Link  string
+		Style graphStyle
+	}
+
+	// graphEdge always point from component (From) to its
+	// dependency (To), renderers will choose arrow direction
+	// based on graph type
+	graphEdge struct {
+		From  string
+		To    string
+		Kind  edgeKind
+		Style graphStyle
+	}
+
+	graphStyle struct {
+		FontSize int
+		Stroke   string
+	}
+)
+
+func (m *graphModel) addNode(name string, kind nodeKind, style graphStyle) {
+	for _, node := range m.Nodes {
+		if node.Name == name {
+			return
+		}
+	}
+
+	m.Nodes = append(m.Nodes, graphNode{
+		Name:  name,
+		Kind:  kind,
+		Style: style,
+	})
+}
+
+func (m *graphModel) addEdge(from, to string, kind edgeKind, style graphStyle) {
+	for _, edge := range m.Edges {
+		if edge.From == from && edge.To == to && edge.Kind == kind {
+			return
+		}
+	}
+
+	m.Edges = append(m.Edges, graphEdge{
+		From:  from,
+		To:    to,
+		Kind:  kind,
+		Style: style,
+	})
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const d2VendorTemplate = `
+{{vnd}}.style.font-size: {{fontSize}}
+{{vnd}}.style.stroke: "{{nodeStroke}}"
+{{cmp}} <- {{vnd}} {
+  style.stroke: "{{edgeStroke}}"
+  source-arrowhead: {
+    shape: diamond
+    style.filled: false
+  }
+}
+`
+
+func renderD2(model graphModel) []byte {
+	flow := d2FlowArrow(model.Type)
+	nodes := make(map[string]graphNode, len(model.Nodes))
+	for _, node := range model.Nodes {
+		nodes[node.Name] = node
+	}
+
+	linesBuff := make([]string, 0, len(model.Edges))
+
+	for _, node := range model.Nodes {
+		if node.Link == "" {
+			continue
+		}
+
+		linesBuff = append(linesBuff, fmt.Sprintf("%s.link: \"%s\"\n", node.Name, node.Link))
+	}
+
+	for _, edge := range model.Edges {
+		if edge.Kind == edgeKindDependency {
+			linesBuff = append(linesBuff, fmt.Sprintf("%s %s %s\n", edge.From, flow, edge.To))
+			continue
+		}
+
+		vendor := nodes[edge.To]
+		vars := map[string]string{
+			"vnd":        edge.To,
+			"cmp":        edge.From,
+			"fontSize":   fmt.Sprintf("%d", vendor.Style.FontSize),
+			"nodeStroke": vendor.Style.Stroke,
+			"edgeStroke": edge.Style.Stroke,
+		}
+
+		tpl := d2VendorTemplate
+		for name, value := range vars {
+			tpl = strings.ReplaceAll(tpl, fmt.Sprintf("{{%s}}", name), value)
+		}
+
+		linesBuff = append(linesBuff, tpl)
+	}
+
+	var buff bytes.Buffer
+	sort.Strings(linesBuff)
+
+	for _, line := range linesBuff {
+		buff.WriteString(line)
+	}
+
+	return buff.Bytes()
+}
+
+func d2FlowArrow(graphType models.GraphType) string {
+	if graphType == models.GraphTypeFlow {
+		return "->"
+	}
+
+	if graphType == models.GraphTypeDI {
+		return "<-"
+	}
+
+	return "--"
+}
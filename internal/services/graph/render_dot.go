@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+func renderDOT(model graphModel) []byte {
+	var buff bytes.Buffer
+
+	buff.WriteString("digraph architecture {\n")
+	buff.WriteString("  node [shape=box];\n")
+
+	for _, node := range model.Nodes {
+		if node.Kind != nodeKindVendor {
+			continue
+		}
+
+		buff.WriteString(fmt.Sprintf("  %s [fontsize=%d, color=%s];\n",
+			strconv.Quote(node.Name),
+			node.Style.FontSize,
+			strconv.Quote(node.Style.Stroke),
+		))
+	}
+
+	depAttrs := dotDependencyAttrs(model.Type)
+
+	for _, edge := range model.Edges {
+		attrs := depAttrs
+		if edge.Kind == edgeKindVendor {
+			attrs = fmt.Sprintf(" [dir=back, arrowtail=odiamond, color=%s]", strconv.Quote(edge.Style.Stroke))
+		}
+
+		buff.WriteString(fmt.Sprintf("  %s -> %s%s;\n",
+			strconv.Quote(edge.From),
+			strconv.Quote(edge.To),
+			attrs,
+		))
+	}
+
+	buff.WriteString("}\n")
+	return buff.Bytes()
+}
+
+func dotDependencyAttrs(graphType models.GraphType) string {
+	if graphType == models.GraphTypeFlow {
+		return ""
+	}
+
+	if graphType == models.GraphTypeDI {
+		return " [dir=back]"
+	}
+
+	return " [dir=none]"
+}
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+func renderMermaid(model graphModel) []byte {
+	var buff bytes.Buffer
+
+	buff.WriteString("flowchart TB\n")
+
+	ids := make(map[string]string, len(model.Nodes))
+	for ind, node := range model.Nodes {
+		ids[node.Name] = fmt.Sprintf("n%d", ind)
+		buff.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", ids[node.Name], mermaidEscape(node.Name)))
+	}
+
+	for _, node := range model.Nodes {
+		if node.Kind != nodeKindVendor {
+			continue
+		}
+
+		buff.WriteString(fmt.Sprintf("  style %s stroke:%s,font-size:%dpx\n",
+			ids[node.Name],
+			node.Style.Stroke,
+			node.Style.FontSize,
+		))
+	}
+
+	for ind, edge := range model.Edges {
+		if edge.Kind == edgeKindVendor {
+			buff.WriteString(fmt.Sprintf("  %s --o %s\n", ids[edge.To], ids[edge.From]))
+			buff.WriteString(fmt.Sprintf("  linkStyle %d stroke:%s\n", ind, edge.Style.Stroke))
+			continue
+		}
+
+		switch model.Type {
+		case models.GraphTypeFlow:
+			buff.WriteString(fmt.Sprintf("  %s --> %s\n", ids[edge.From], ids[edge.To]))
+		case models.GraphTypeDI:
+			buff.WriteString(fmt.Sprintf("  %s --> %s\n", ids[edge.To], ids[edge.From]))
+		default:
+			buff.WriteString(fmt.Sprintf("  %s --- %s\n", ids[edge.From], ids[edge.To]))
+		}
+	}
+
+	return buff.Bytes()
+}
+
+func mermaidEscape(name string) string {
+	return strings.ReplaceAll(name, `"`, "#quot;")
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+func renderPlantUML(model graphModel) []byte {
+	var buff bytes.Buffer
+
+	buff.WriteString("@startuml\n")
+
+	ids := make(map[string]string, len(model.Nodes))
+	for ind, node := range model.Nodes {
+		ids[node.Name] = fmt.Sprintf("c%d", ind)
+
+		if node.Kind == nodeKindVendor {
+			buff.WriteString(fmt.Sprintf("component \"<size:%d>%s</size>\" as %s #line:%s\n",
+				node.Style.FontSize,
+				plantUMLEscape(node.Name),
+				ids[node.Name],
+				strings.TrimPrefix(node.Style.Stroke, "#"),
+			))
+			continue
+		}
+
+		buff.WriteString(fmt.Sprintf("component \"%s\" as %s\n", plantUMLEscape(node.Name), ids[node.Name]))
+	}
+
+	flow := plantUMLFlowArrow(model.Type)
+
+	for _, edge := range model.Edges {
+		if edge.Kind == edgeKindVendor {
+			buff.WriteString(fmt.Sprintf("%s o-[%s]- %s\n", ids[edge.From], edge.Style.Stroke, ids[edge.To]))
+			continue
+		}
+
+		buff.WriteString(fmt.Sprintf("%s %s %s\n", ids[edge.From], flow, ids[edge.To]))
+	}
+
+	buff.WriteString("@enduml\n")
+	return buff.Bytes()
+}
+
+func plantUMLFlowArrow(graphType models.GraphType) string {
+	if graphType == models.GraphTypeFlow {
+		return "-->"
+	}
+
+	if graphType == models.GraphTypeDI {
+		return "<--"
+	}
+
+	return "--"
+}
+
+func plantUMLEscape(name string) string {
+	return strings.ReplaceAll(name, `"`, `\"`)
+}
@@ -0,0 +1,501 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	xfont "golang.org/x/image/font"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/font/liberation"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/vgimg"
+	"gonum.org/v1/plot/vg/vgpdf"
+	"oss.terrastruct.com/d2/d2target"
+	"oss.terrastruct.com/d2/d2themes"
+	d2color "oss.terrastruct.com/d2/lib/color"
+	"oss.terrastruct.com/d2/lib/geo"
+	"oss.terrastruct.com/d2/lib/label"
+	"oss.terrastruct.com/d2/lib/svg"
+)
+
+// rasterDPI used for png output, diagram is rendered in 2x scale
+// for better quality on hi-dpi screens and slides
+const rasterDPI = 144
+
+// ellipse approximation with cubic bezier curves
+const bezierCircleK = 0.5522847498
+
+type (
+	// rasterRenderer draw already positioned d2 diagram on vg canvas,
+	// this allows to output png and pdf without headless browser
+	// (d2 lib itself can render png only with playwright)
+	rasterRenderer struct {
+		canvas  vg.Canvas
+		theme   d2themes.Theme
+		fonts   *font.Cache
+		offsetX float64
+		offsetY float64
+		height  float64
+
+		sketch bool
+		random *rand.Rand
+	}
+
+	rasterElement struct {
+		zIndex     int
+		shape      *d2target.Shape
+		connection *d2target.Connection
+	}
+)
+
+func renderPNG(diagram *d2target.Diagram, theme d2themes.Theme, padding int, sketch bool) ([]byte, error) {
+	width, height := rasterSize(diagram, padding)
+	canvas := vgimg.NewWith(
+		vgimg.UseWH(vg.Length(width), vg.Length(height)),
+		vgimg.UseDPI(rasterDPI),
+	)
+
+	renderRaster(canvas, diagram, theme, padding, sketch)
+
+	var buff bytes.Buffer
+	_, err := vgimg.PngCanvas{Canvas: canvas}.WriteTo(&buff)
+	if err != nil {
+		return nil, fmt.Errorf("failed encode png: %w", err)
+	}
+
+	return buff.Bytes(), nil
+}
+
+func renderPDF(diagram *d2target.Diagram, theme d2themes.Theme, padding int, sketch bool) ([]byte, error) {
+	width, height := rasterSize(diagram, padding)
+	canvas := vgpdf.New(vg.Length(width), vg.Length(height))
+
+	renderRaster(canvas, diagram, theme, padding, sketch)
+
+	var buff bytes.Buffer
+	_, err := canvas.WriteTo(&buff)
+	if err != nil {
+		return nil, fmt.Errorf("failed encode pdf: %w", err)
+	}
+
+	return buff.Bytes(), nil
+}
+
+func rasterBoundingBox(diagram *d2target.Diagram) (minX, minY, maxX, maxY float64) {
+	topLeft, bottomRight := diagram.BoundingBox()
+	minX, minY = float64(topLeft.X), float64(topLeft.Y)
+	maxX, maxY = float64(bottomRight.X), float64(bottomRight.Y)
+
+	// self-loops and long routes can go outside of shapes
+	for _, connection := range diagram.Connections {
+		for _, point := range connection.Route {
+			minX, minY = math.Min(minX, point.X), math.Min(minY, point.Y)
+			maxX, maxY = math.Max(maxX, point.X), math.Max(maxY, point.Y)
+		}
+	}
+
+	return minX, minY, maxX, maxY
+}
+
+func rasterSize(diagram *d2target.Diagram, padding int) (width, height float64) {
+	minX, minY, maxX, maxY := rasterBoundingBox(diagram)
+
+	return maxX - minX + float64(padding*2), maxY - minY + float64(padding*2)
+}
+
+func renderRaster(canvas vg.Canvas, diagram *d2target.Diagram, theme d2themes.Theme, padding int, sketch bool) {
+	minX, minY, _, _ := rasterBoundingBox(diagram)
+	width, height := rasterSize(diagram, padding)
+
+	r := &rasterRenderer{
+		canvas:  canvas,
+		theme:   theme,
+		fonts:   font.NewCache(liberation.Collection()),
+		offsetX: float64(padding) - minX,
+		offsetY: float64(padding) - minY,
+		height:  height,
+		sketch:  sketch,
+		random:  rand.New(rand.NewSource(sketchSeed)),
+	}
+
+	// background
+	background := vg.Rectangle{Max: vg.Point{X: vg.Length(width), Y: vg.Length(height)}}
+	r.fill(background.Path(), d2target.BG_COLOR, 1)
+
+	// d2 draw all objects sorted by z-index, shapes first
+	elements := make([]rasterElement, 0, len(diagram.Shapes)+len(diagram.Connections))
+	for ind := range diagram.Shapes {
+		elements = append(elements, rasterElement{zIndex: diagram.Shapes[ind].ZIndex, shape: &diagram.Shapes[ind]})
+	}
+	for ind := range diagram.Connections {
+		elements = append(elements, rasterElement{zIndex: diagram.Connections[ind].ZIndex, connection: &diagram.Connections[ind]})
+	}
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].zIndex < elements[j].zIndex
+	})
+
+	for _, element := range elements {
+		if element.shape != nil {
+			r.drawShape(*element.shape)
+			continue
+		}
+
+		r.drawConnection(*element.connection)
+	}
+}
+
+func (r *rasterRenderer) drawShape(shape d2target.Shape) {
+	if shape.Type == d2target.ShapeText {
+		r.drawShapeLabel(shape)
+		return
+	}
+
+	fill, stroke := d2themes.ShapeTheme(shape)
+	x, y := float64(shape.Pos.X), float64(shape.Pos.Y)
+	w, h := float64(shape.Width), float64(shape.Height)
+
+	if shape.Multiple {
+		path := r.shapePath(shape.Type, x+d2target.MULTIPLE_OFFSET, y-d2target.MULTIPLE_OFFSET, w, h, shape.BorderRadius)
+		r.fillShape(path, fill, stroke, shape.Opacity)
+		r.stroke(path, stroke, shape.StrokeWidth, shape.StrokeDash, shape.Opacity)
+	}
+
+	path := r.shapePath(shape.Type, x, y, w, h, shape.BorderRadius)
+	r.fillShape(path, fill, stroke, shape.Opacity)
+	r.stroke(path, stroke, shape.StrokeWidth, shape.StrokeDash, shape.Opacity)
+
+	if shape.DoubleBorder {
+		inner := r.shapePath(shape.Type,
+			x+d2target.INNER_BORDER_OFFSET,
+			y+d2target.INNER_BORDER_OFFSET,
+			w-d2target.INNER_BORDER_OFFSET*2,
+			h-d2target.INNER_BORDER_OFFSET*2,
+			shape.BorderRadius,
+		)
+		r.stroke(inner, stroke, shape.StrokeWidth, shape.StrokeDash, shape.Opacity)
+	}
+
+	r.drawShapeLabel(shape)
+}
+
+func (r *rasterRenderer) drawShapeLabel(shape d2target.Shape) {
+	if shape.Label == "" || shape.LabelPosition == "" {
+		return
+	}
+
+	box := geo.NewBox(
+		geo.NewPoint(float64(shape.Pos.X), float64(shape.Pos.Y)),
+		float64(shape.Width),
+		float64(shape.Height),
+	)
+
+	topLeft := label.Position(shape.LabelPosition).GetPointOnBox(
+		box,
+		label.PADDING,
+		float64(shape.LabelWidth),
+		float64(shape.LabelHeight),
+	)
+
+	r.drawText(shape.Text, shape.GetFontColor(), topLeft.X, topLeft.Y, float64(shape.LabelWidth), float64(shape.LabelHeight))
+}
+
+func (r *rasterRenderer) drawConnection(connection d2target.Connection) {
+	if len(connection.Route) < 2 {
+		return
+	}
+
+	r.stroke(r.connectionPath(connection), connection.Stroke, connection.StrokeWidth, connection.StrokeDash, connection.Opacity)
+
+	last := len(connection.Route) - 1
+	r.drawArrowhead(connection, connection.DstArrow, connection.Route[last-1], connection.Route[last])
+	r.drawArrowhead(connection, connection.SrcArrow, connection.Route[1], connection.Route[0])
+
+	if connection.Label != "" {
+		topLeft := connection.GetLabelTopLeft()
+		if topLeft != nil {
+			r.drawText(connection.Text, connection.GetFontColor(), topLeft.X, topLeft.Y, float64(connection.LabelWidth), float64(connection.LabelHeight))
+		}
+	}
+}
+
+func (r *rasterRenderer) connectionPath(connection d2target.Connection) vg.Path {
+	route := connection.Route
+	path := vg.Path{}
+	path.Move(r.point(route[0].X, route[0].Y))
+
+	if connection.IsCurve {
+		for i := 1; i+2 < len(route); i += 3 {
+			path.CubeTo(
+				r.point(route[i].X, route[i].Y),
+				r.point(route[i+1].X, route[i+1].Y),
+				r.point(route[i+2].X, route[i+2].Y),
+			)
+		}
+
+		return path
+	}
+
+	// polyline with rounded corners
+	for i := 1; i < len(route)-1; i++ {
+		prev, curr, next := route[i-1], route[i], route[i+1]
+
+		radius := math.Min(connection.BorderRadius, math.Min(
+			geo.EuclideanDistance(prev.X, prev.Y, curr.X, curr.Y)/2,
+			geo.EuclideanDistance(curr.X, curr.Y, next.X, next.Y)/2,
+		))
+
+		in := prev.VectorTo(curr).Unit().Multiply(radius).ToPoint()
+		out := curr.VectorTo(next).Unit().Multiply(radius).ToPoint()
+
+		path.Line(r.point(curr.X-in.X, curr.Y-in.Y))
+		path.QuadTo(r.point(curr.X, curr.Y), r.point(curr.X+out.X, curr.Y+out.Y))
+	}
+
+	end := route[len(route)-1]
+	path.Line(r.point(end.X, end.Y))
+
+	return path
+}
+
+// drawArrowhead draw arrowhead with tip in "to" point, directed from "from" point
+func (r *rasterRenderer) drawArrowhead(connection d2target.Connection, arrowhead d2target.Arrowhead, from, to *geo.Point) {
+	if arrowhead == d2target.NoArrowhead || arrowhead == "" {
+		return
+	}
+
+	width, height := arrowhead.Dimensions(float64(connection.StrokeWidth))
+	tip := r.point(to.X, to.Y)
+	base := r.point(from.X, from.Y)
+	angle := math.Atan2(float64(tip.Y-base.Y), float64(tip.X-base.X))
+
+	// local coordinates: tip in (0,0), arrowhead directed to +X
+	local := func(x, y float64) vg.Point {
+		return vg.Point{
+			X: tip.X + vg.Length(x*math.Cos(angle)-y*math.Sin(angle)),
+			Y: tip.Y + vg.Length(x*math.Sin(angle)+y*math.Cos(angle)),
+		}
+	}
+
+	path := vg.Path{}
+	filled := true
+
+	switch arrowhead {
+	case d2target.DiamondArrowhead, d2target.FilledDiamondArrowhead:
+		filled = arrowhead == d2target.FilledDiamondArrowhead
+		path.Move(local(0, 0))
+		path.Line(local(-width/2, height/2))
+		path.Line(local(-width, 0))
+		path.Line(local(-width/2, -height/2))
+		path.Close()
+	case d2target.CircleArrowhead, d2target.FilledCircleArrowhead:
+		filled = arrowhead == d2target.FilledCircleArrowhead
+		path = r.ellipsePath(local(-width/2, 0), width/2, width/2)
+	case d2target.ArrowArrowhead:
+		path.Move(local(0, 0))
+		path.Line(local(-width, height/2))
+		path.Line(local(-width*0.6, 0))
+		path.Line(local(-width, -height/2))
+		path.Close()
+	case d2target.LineArrowhead:
+		path.Move(local(-width, height/2))
+		path.Line(local(0, 0))
+		path.Line(local(-width, -height/2))
+		r.stroke(path, connection.Stroke, connection.StrokeWidth, 0, connection.Opacity)
+		return
+	default:
+		path.Move(local(0, 0))
+		path.Line(local(-width, height/2))
+		path.Line(local(-width, -height/2))
+		path.Close()
+	}
+
+	if filled {
+		r.fill(path, connection.Stroke, connection.Opacity)
+	} else {
+		r.fill(path, d2target.BG_COLOR, connection.Opacity)
+	}
+
+	r.stroke(path, connection.Stroke, connection.StrokeWidth, 0, connection.Opacity)
+}
+
+func (r *rasterRenderer) drawText(text d2target.Text, textColor string, x, y, width, height float64) {
+	content := text.Label
+	if r.theme.SpecialRules.CapsLock {
+		content = strings.ToUpper(content)
+	}
+
+	variant := font.Variant("Sans")
+	if r.theme.SpecialRules.Mono || text.FontFamily == "mono" {
+		variant = "Mono"
+	}
+
+	fnt := font.Font{
+		Typeface: "Liberation",
+		Variant:  variant,
+		Style:    xfont.StyleNormal,
+		Weight:   xfont.WeightNormal,
+	}
+
+	if text.Bold {
+		fnt.Weight = xfont.WeightBold
+	}
+
+	if text.Italic {
+		fnt.Style = xfont.StyleItalic
+	}
+
+	face := r.fonts.Lookup(fnt, vg.Length(text.FontSize))
+
+	// vgpdf register embedded fonts without style, but select them
+	// with style (B, I) later, so bold/italic faces can't be found.
+	// Face data already contain correct glyphs, so we move style
+	// into unique font name, and keep pdf style empty
+	face.Font.Variant = font.Variant(strings.TrimPrefix(face.Font.Name(), string(face.Font.Typeface)))
+	face.Font.Weight = xfont.WeightNormal
+	face.Font.Style = xfont.StyleNormal
+	extents := face.Extents()
+	lines := strings.Split(content, "\n")
+	lineHeight := float64(extents.Height)
+	textHeight := lineHeight * float64(len(lines))
+
+	r.canvas.Push()
+	r.canvas.SetColor(r.color(textColor, 1))
+
+	for ind, line := range lines {
+		lineWidth := float64(face.Width(line))
+
+		// centered in label box, y is baseline
+		lineX := x + (width-lineWidth)/2
+		lineY := y + (height-textHeight)/2 + lineHeight*float64(ind) + float64(extents.Ascent)
+
+		r.canvas.FillString(face, r.point(lineX, lineY), line)
+	}
+
+	r.canvas.Pop()
+}
+
+func (r *rasterRenderer) shapePath(shapeType string, x, y, w, h float64, borderRadius int) vg.Path {
+	if shapeType == d2target.ShapeOval || shapeType == d2target.ShapeCircle {
+		return r.ellipsePath(r.point(x+w/2, y+h/2), w/2, h/2)
+	}
+
+	radius := math.Min(float64(borderRadius), math.Min(w, h)/2)
+	if r.theme.SpecialRules.NoCornerRadius {
+		radius = 0
+	}
+
+	path := vg.Path{}
+	path.Move(r.point(x+radius, y))
+	path.Line(r.point(x+w-radius, y))
+	path.QuadTo(r.point(x+w, y), r.point(x+w, y+radius))
+	path.Line(r.point(x+w, y+h-radius))
+	path.QuadTo(r.point(x+w, y+h), r.point(x+w-radius, y+h))
+	path.Line(r.point(x+radius, y+h))
+	path.QuadTo(r.point(x, y+h), r.point(x, y+h-radius))
+	path.Line(r.point(x, y+radius))
+	path.QuadTo(r.point(x, y), r.point(x+radius, y))
+	path.Close()
+
+	return path
+}
+
+func (r *rasterRenderer) ellipsePath(center vg.Point, rx, ry float64) vg.Path {
+	kx, ky := vg.Length(rx*bezierCircleK), vg.Length(ry*bezierCircleK)
+	lx, ly := vg.Length(rx), vg.Length(ry)
+	cx, cy := center.X, center.Y
+
+	path := vg.Path{}
+	path.Move(vg.Point{X: cx + lx, Y: cy})
+	path.CubeTo(vg.Point{X: cx + lx, Y: cy + ky}, vg.Point{X: cx + kx, Y: cy + ly}, vg.Point{X: cx, Y: cy + ly})
+	path.CubeTo(vg.Point{X: cx - kx, Y: cy + ly}, vg.Point{X: cx - lx, Y: cy + ky}, vg.Point{X: cx - lx, Y: cy})
+	path.CubeTo(vg.Point{X: cx - lx, Y: cy - ky}, vg.Point{X: cx - kx, Y: cy - ly}, vg.Point{X: cx, Y: cy - ly})
+	path.CubeTo(vg.Point{X: cx + kx, Y: cy - ly}, vg.Point{X: cx + lx, Y: cy - ky}, vg.Point{X: cx + lx, Y: cy})
+	path.Close()
+
+	return path
+}
+
+func (r *rasterRenderer) fill(path vg.Path, colorCode string, opacity float64) {
+	clr := r.color(colorCode, opacity)
+	if clr == nil {
+		return
+	}
+
+	r.canvas.Push()
+	r.canvas.SetColor(clr)
+	r.canvas.Fill(path)
+	r.canvas.Pop()
+}
+
+// fillShape fill shape background, in sketch style
+// it also have hachure lines with stroke color
+func (r *rasterRenderer) fillShape(path vg.Path, fillColor string, strokeColor string, opacity float64) {
+	r.fill(path, fillColor, opacity)
+
+	if r.sketch {
+		r.sketchHachure(path, strokeColor, opacity)
+	}
+}
+
+func (r *rasterRenderer) stroke(path vg.Path, colorCode string, width int, dash float64, opacity float64) {
+	clr := r.color(colorCode, opacity)
+	if clr == nil || width <= 0 {
+		return
+	}
+
+	r.canvas.Push()
+	r.canvas.SetColor(clr)
+	r.canvas.SetLineWidth(vg.Length(width))
+
+	if dash != 0 {
+		dashSize, gapSize := svg.GetStrokeDashAttributes(float64(width), dash)
+		r.canvas.SetLineDash([]vg.Length{vg.Length(dashSize), vg.Length(gapSize)}, 0)
+	}
+
+	if r.sketch {
+		r.sketchStroke(path)
+	} else {
+		r.canvas.Stroke(path)
+	}
+
+	r.canvas.Pop()
+}
+
+// point convert d2 diagram coordinates (top-left origin)
+// to vg canvas coordinates (bottom-left origin)
+func (r *rasterRenderer) point(x, y float64) vg.Point {
+	return vg.Point{
+		X: vg.Length(x + r.offsetX),
+		Y: vg.Length(r.height - (y + r.offsetY)),
+	}
+}
+
+// color resolve d2 theme color code (like "B1", "N7") or css color
+// into drawable color, nil is returned for transparent colors
+func (r *rasterRenderer) color(code string, opacity float64) color.Color {
+	resolved := d2themes.ResolveThemeColor(r.theme, code)
+	if resolved == d2color.Empty || resolved == d2color.None || resolved == "transparent" {
+		return nil
+	}
+
+	rgb := d2color.Name2RGB(resolved)
+	if strings.HasPrefix(resolved, "#") {
+		parsed, err := d2color.Hex2RGB(resolved)
+		if err == nil {
+			rgb = parsed
+		}
+	}
+
+	if opacity <= 0 || opacity > 1 {
+		opacity = 1
+	}
+
+	alpha := uint8(math.Round(255 * opacity))
+	return color.NRGBA{R: rgb.Red, G: rgb.Green, B: rgb.Blue, A: alpha}
+}
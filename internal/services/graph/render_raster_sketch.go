@@ -0,0 +1,197 @@
+package graph
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot/vg"
+)
+
+// sketch style is close to d2 svg sketch (rough.js): every line is
+// drawn twice with small random offsets and bowing, shapes have
+// hachure fill. Random is seeded, so output is always the same
+const (
+	sketchSeed           = 1
+	sketchRoughness      = 1.5  // max random offset of line points
+	sketchBowing         = 0.02 // max bowing of line middle (relative to length)
+	sketchCurveSteps     = 12   // line segments count for one bezier curve
+	sketchHachureGap     = 6
+	sketchHachureAngle   = math.Pi / 4
+	sketchHachureOpacity = 0.25
+)
+
+// sketchStroke draw path in hand-drawn style
+func (r *rasterRenderer) sketchStroke(path vg.Path) {
+	for pass := 0; pass < 2; pass++ {
+		for _, polyline := range flattenPath(path) {
+			rough := vg.Path{}
+			rough.Move(r.jitter(polyline[0], sketchRoughness))
+
+			for ind := 1; ind < len(polyline); ind++ {
+				r.roughLineTo(&rough, polyline[ind-1], polyline[ind])
+			}
+
+			r.canvas.Stroke(rough)
+		}
+	}
+}
+
+// sketchHachure fill closed path with parallel rough lines
+func (r *rasterRenderer) sketchHachure(path vg.Path, colorCode string, opacity float64) {
+	clr := r.color(colorCode, opacity*sketchHachureOpacity)
+	if clr == nil {
+		return
+	}
+
+	polygons := flattenPath(path)
+	if len(polygons) == 0 {
+		return
+	}
+
+	// rotate polygon, so hachure lines become horizontal
+	sin, cos := math.Sincos(-sketchHachureAngle)
+	rotate := func(point vg.Point, sin, cos float64) (float64, float64) {
+		x, y := float64(point.X), float64(point.Y)
+		return x*cos - y*sin, x*sin + y*cos
+	}
+
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, polygon := range polygons {
+		for _, point := range polygon {
+			_, y := rotate(point, sin, cos)
+			minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+		}
+	}
+
+	r.canvas.Push()
+	r.canvas.SetColor(clr)
+	r.canvas.SetLineWidth(1)
+
+	for scanY := minY + sketchHachureGap/2; scanY < maxY; scanY += sketchHachureGap {
+		crossings := make([]float64, 0, 4)
+
+		for _, polygon := range polygons {
+			for ind := 1; ind < len(polygon); ind++ {
+				x1, y1 := rotate(polygon[ind-1], sin, cos)
+				x2, y2 := rotate(polygon[ind], sin, cos)
+
+				if (y1 <= scanY) == (y2 <= scanY) {
+					continue
+				}
+
+				crossings = append(crossings, x1+(scanY-y1)/(y2-y1)*(x2-x1))
+			}
+		}
+
+		sort.Float64s(crossings)
+
+		for ind := 0; ind+1 < len(crossings); ind += 2 {
+			from := rotatedPoint(crossings[ind], scanY)
+			to := rotatedPoint(crossings[ind+1], scanY)
+
+			line := vg.Path{}
+			line.Move(r.jitter(from, sketchRoughness/2))
+			r.roughLineTo(&line, from, to)
+			r.canvas.Stroke(line)
+		}
+	}
+
+	r.canvas.Pop()
+}
+
+// rotatedPoint rotate point from hachure space back to canvas space
+func rotatedPoint(x, y float64) vg.Point {
+	sin, cos := math.Sincos(sketchHachureAngle)
+
+	return vg.Point{
+		X: vg.Length(x*cos - y*sin),
+		Y: vg.Length(x*sin + y*cos),
+	}
+}
+
+// roughLineTo add line to path, that slightly bowed in the
+// middle and end in random point near "to"
+func (r *rasterRenderer) roughLineTo(path *vg.Path, from, to vg.Point) {
+	dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return
+	}
+
+	// short segments (flatten curves) should be almost straight
+	roughness := math.Min(sketchRoughness, length/10)
+	bowing := (r.random.Float64()*2 - 1) * length * sketchBowing
+
+	middle := vg.Point{
+		X: from.X + vg.Length(dx/2-dy/length*bowing),
+		Y: from.Y + vg.Length(dy/2+dx/length*bowing),
+	}
+
+	path.QuadTo(r.jitter(middle, roughness), r.jitter(to, roughness))
+}
+
+func (r *rasterRenderer) jitter(point vg.Point, roughness float64) vg.Point {
+	return vg.Point{
+		X: point.X + vg.Length((r.random.Float64()*2-1)*roughness),
+		Y: point.Y + vg.Length((r.random.Float64()*2-1)*roughness),
+	}
+}
+
+// flattenPath convert path into polylines (one for every sub path),
+// bezier curves is approximated with line segments
+func flattenPath(path vg.Path) [][]vg.Point {
+	polylines := make([][]vg.Point, 0, 1)
+	current := make([]vg.Point, 0)
+
+	flush := func() {
+		if len(current) > 1 {
+			polylines = append(polylines, current)
+		}
+
+		current = make([]vg.Point, 0)
+	}
+
+	for _, component := range path {
+		switch component.Type {
+		case vg.MoveComp:
+			flush()
+			current = append(current, component.Pos)
+		case vg.LineComp:
+			current = append(current, component.Pos)
+		case vg.CurveComp:
+			if len(current) == 0 {
+				continue
+			}
+
+			points := append([]vg.Point{current[len(current)-1]}, component.Control...)
+			points = append(points, component.Pos)
+
+			for step := 1; step <= sketchCurveSteps; step++ {
+				current = append(current, bezierPoint(points, float64(step)/sketchCurveSteps))
+			}
+		case vg.CloseComp:
+			if len(current) > 0 {
+				current = append(current, current[0])
+			}
+		}
+	}
+
+	flush()
+	return polylines
+}
+
+// bezierPoint find point on bezier curve of any order (de Casteljau)
+func bezierPoint(points []vg.Point, t float64) vg.Point {
+	work := append([]vg.Point{}, points...)
+
+	for level := len(work) - 1; level > 0; level-- {
+		for ind := 0; ind < level; ind++ {
+			work[ind] = vg.Point{
+				X: work[ind].X + vg.Length(t)*(work[ind+1].X-work[ind].X),
+				Y: work[ind].Y + vg.Length(t)*(work[ind+1].Y-work[ind].Y),
+			}
+		}
+	}
+
+	return work[0]
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/plot/vg"
+	"oss.terrastruct.com/d2/d2themes/d2themescatalog"
+)
+
+const testD2Code = `
+main -> container
+container -> services
+vendor.style.stroke: "#77AA44"
+services <- vendor {
+  source-arrowhead: {
+    shape: diamond
+    style.filled: false
+  }
+}
+`
+
+func TestRenderRaster(t *testing.T) {
+	renderer := NewRenderer()
+
+	for _, layout := range models.GraphLayoutsValues {
+		diagram, err := renderer.compileDiagram(context.Background(), []byte(testD2Code), layout)
+		assert.NoError(t, err)
+
+		for _, sketch := range []bool{false, true} {
+			png, err := renderPNG(diagram, d2themescatalog.NeutralDefault, models.DefaultGraphPadding, sketch)
+			assert.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(png, []byte("\x89PNG")), "layout %s: png signature", layout)
+
+			pdf, err := renderPDF(diagram, d2themescatalog.DarkMauve, 0, sketch)
+			assert.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF")), "layout %s: pdf signature", layout)
+		}
+	}
+}
+
+func TestRenderRasterSketch(t *testing.T) {
+	diagram, err := NewRenderer().compileDiagram(context.Background(), []byte(testD2Code), models.GraphLayoutDagre)
+	assert.NoError(t, err)
+
+	plain, err := renderPNG(diagram, d2themescatalog.NeutralDefault, 0, false)
+	assert.NoError(t, err)
+
+	sketch, err := renderPNG(diagram, d2themescatalog.NeutralDefault, 0, true)
+	assert.NoError(t, err)
+
+	sketchAgain, err := renderPNG(diagram, d2themescatalog.NeutralDefault, 0, true)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, plain, sketch, "sketch style should change image")
+	assert.Equal(t, sketch, sketchAgain, "sketch style should be deterministic")
+}
+
+func TestFlattenPath(t *testing.T) {
+	path := vg.Path{}
+	path.Move(vg.Point{X: 0, Y: 0})
+	path.Line(vg.Point{X: 10, Y: 0})
+	path.QuadTo(vg.Point{X: 10, Y: 10}, vg.Point{X: 0, Y: 10})
+	path.Close()
+
+	polylines := flattenPath(path)
+	assert.Len(t, polylines, 1)
+	assert.Len(t, polylines[0], 2+sketchCurveSteps+1)
+	assert.Equal(t, vg.Point{X: 0, Y: 10}, polylines[0][1+sketchCurveSteps])
+	assert.Equal(t, polylines[0][0], polylines[0][len(polylines[0])-1])
+}
+
+func TestFindTheme(t *testing.T) {
+	renderer := NewRenderer()
+
+	theme, err := renderer.findTheme("neutral-default")
+	assert.NoError(t, err)
+	assert.Equal(t, d2themescatalog.NeutralDefault.ID, theme.ID)
+
+	theme, err = renderer.findTheme("200")
+	assert.NoError(t, err)
+	assert.Equal(t, d2themescatalog.DarkMauve.ID, theme.ID)
+
+	_, err = renderer.findTheme("unknown")
+	assert.Error(t, err)
+}
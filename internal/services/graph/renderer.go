@@ -0,0 +1,265 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+// Renderer build components graph from spec and render it in
+// text formats (d2, dot, mermaid, plantuml) or compile it to images
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// ValidateTheme check that d2 theme exist, before doing any work
+func (r *Renderer) ValidateTheme(name string) error {
+	_, err := r.findTheme(name)
+	return err
+}
+
+// Definitions build graph from spec and return its d2 code (source for images) and
+// definitions in requested format (d2 code itself for image formats)
+func (r *Renderer) Definitions(spec arch.Spec, opts models.CmdGraphIn) (d2Code []byte, definitions []byte, err error) {
+	graph, err := r.buildGraph(spec, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed build graph: %w", err)
+	}
+
+	definitions, err = r.renderGraph(graph, opts.Format)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to render graph: %w", err)
+	}
+
+	return renderD2(graph), definitions, nil
+}
+
+// renderGraph will render graph source code in specified format,
+// images is compiled from d2 source, so d2 definitions returned for them
+func (r *Renderer) renderGraph(graph graphModel, format models.GraphFormat) ([]byte, error) {
+	switch format {
+	case models.GraphFormatSVG, models.GraphFormatPNG, models.GraphFormatPDF, models.GraphFormatD2:
+		return renderD2(graph), nil
+	case models.GraphFormatDOT:
+		return renderDOT(graph), nil
+	case models.GraphFormatMermaid:
+		return renderMermaid(graph), nil
+	case models.GraphFormatPlantUML:
+		return renderPlantUML(graph), nil
+	default:
+		return nil, fmt.Errorf("unknown graph format '%s'", format)
+	}
+}
+
+func (r *Renderer) buildGraph(spec arch.Spec, opts models.CmdGraphIn) (graphModel, error) {
+	whiteList, err := r.populateGraphWhitelist(spec, opts)
+	if err != nil {
+		return graphModel{}, err
+	}
+
+	graph := graphModel{
+		Type: opts.Type,
+	}
+
+	vendorStyle := graphStyle{
+		FontSize: vendorFontSize,
+		Stroke:   vendorColor,
+	}
+
+	for _, cmp := range spec.Components {
+		if _, visible := whiteList[cmp.Name.Value]; !visible {
+			continue
+		}
+
+		cmpNode, err := r.groupName(cmp.Name.Value, opts.Groups)
+		if err != nil {
+			return graphModel{}, err
+		}
+
+		for _, dep := range cmp.MayDependOn {
+			if _, visible := whiteList[dep.Value]; !visible {
+				continue
+			}
+
+			depNode, err := r.groupName(dep.Value, opts.Groups)
+			if err != nil {
+				return graphModel{}, err
+			}
+
+			if cmpNode == depNode && cmp.Name.Value != dep.Value {
+				// dependency inside collapsed group
+				continue
+			}
+
+			graph.addNode(cmpNode, nodeKindComponent, graphStyle{})
+			graph.addNode(depNode, nodeKindComponent, graphStyle{})
+			graph.addEdge(cmpNode, depNode, edgeKindDependency, graphStyle{})
+		}
+
+		if opts.IncludeVendors {
+			for _, vnd := range cmp.CanUse {
+				graph.addNode(cmpNode, nodeKindComponent, graphStyle{})
+				graph.addNode(vnd.Value, nodeKindVendor, vendorStyle)
+				graph.addEdge(cmpNode, vnd.Value, edgeKindVendor, graphStyle{Stroke: vendorColor})
+			}
+		}
+	}
+
+	if opts.NodeLink != "" {
+		for ind := range graph.Nodes {
+			if graph.Nodes[ind].Kind != nodeKindComponent {
+				continue
+			}
+
+			graph.Nodes[ind].Link = fmt.Sprintf(opts.NodeLink, graph.Nodes[ind].Name)
+		}
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].Name < graph.Nodes[j].Name
+	})
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+
+	return graph, nil
+}
+
+func (r *Renderer) populateGraphWhitelist(spec arch.Spec, opts models.CmdGraphIn) (map[string]struct{}, error) {
+	if len(opts.Focus) == 0 {
+		return r.populateGraphWhitelistAll(spec)
+	}
+
+	return r.populateGraphWhitelistFocused(spec, opts)
+}
+
+func (r *Renderer) populateGraphWhitelistAll(spec arch.Spec) (map[string]struct{}, error) {
+	whiteList := make(map[string]struct{}, len(spec.Components))
+
+	for _, cmp := range spec.Components {
+		whiteList[cmp.Name.Value] = struct{}{}
+	}
+
+	return whiteList, nil
+}
+
+func (r *Renderer) populateGraphWhitelistFocused(spec arch.Spec, opts models.CmdGraphIn) (map[string]struct{}, error) {
+	roots, err := r.resolveFocusedComponents(spec, opts.Focus)
+	if err != nil {
+		return nil, err
+	}
+
+	// component -> next components to walk
+	links := make(map[string][]string, len(spec.Components))
+	for _, cmp := range spec.Components {
+		for _, dep := range cmp.MayDependOn {
+			if opts.FocusReverse {
+				links[dep.Value] = append(links[dep.Value], cmp.Name.Value)
+				continue
+			}
+
+			links[cmp.Name.Value] = append(links[cmp.Name.Value], dep.Value)
+		}
+	}
+
+	type walkItem struct {
+		name  string
+		depth int
+	}
+
+	whiteList := make(map[string]struct{}, len(spec.Components))
+	resolveList := make([]walkItem, 0, 64)
+
+	for _, root := range roots {
+		resolveList = append(resolveList, walkItem{name: root, depth: 0})
+	}
+
+	for len(resolveList) > 0 {
+		item := resolveList[0]
+		resolveList = resolveList[1:]
+
+		if _, alreadyResolved := whiteList[item.name]; alreadyResolved {
+			continue
+		}
+
+		// cmp itself
+		whiteList[item.name] = struct{}{}
+
+		if opts.Depth > 0 && item.depth >= opts.Depth {
+			continue
+		}
+
+		// cmp deps (or dependents in reverse mode)
+		for _, next := range links[item.name] {
+			resolveList = append(resolveList, walkItem{name: next, depth: item.depth + 1})
+		}
+	}
+
+	return whiteList, nil
+}
+
+func (r *Renderer) resolveFocusedComponents(spec arch.Spec, focus []string) ([]string, error) {
+	roots := make([]string, 0, len(focus))
+
+	for _, focusPattern := range focus {
+		matched := false
+
+		for _, cmp := range spec.Components {
+			isMatched, err := models.Glob(focusPattern).Match(cmp.Name.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid focus '%s': %w", focusPattern, err)
+			}
+
+			if !isMatched {
+				continue
+			}
+
+			matched = true
+			roots = append(roots, cmp.Name.Value)
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("focused cmp %s is not defined", focusPattern)
+		}
+	}
+
+	return roots, nil
+}
+
+// groupName returns name of graph node for component,
+// components matched by some group glob is collapsed into single node
+func (r *Renderer) groupName(cmpName string, groups []string) (string, error) {
+	for _, group := range groups {
+		isMatched, err := models.Glob(group).Match(cmpName)
+		if err != nil {
+			return "", fmt.Errorf("invalid group '%s': %w", group, err)
+		}
+
+		if !isMatched {
+			continue
+		}
+
+		name := group
+		if globInd := strings.Index(group, "*"); globInd != -1 {
+			name = strings.TrimSuffix(group[:globInd], "/")
+		}
+
+		if name == "" {
+			return group, nil
+		}
+
+		return name, nil
+	}
+
+	return cmpName, nil
+}
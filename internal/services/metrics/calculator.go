@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+)
+
+type (
+	Calculator struct {
+		projectFilesResolver projectFilesResolver
+		scanner              *deepscan.Searcher
+	}
+
+	componentStats struct {
+		afferent      map[string]struct{}
+		efferent      map[string]struct{}
+		interfaces    int
+		concreteTypes int
+	}
+)
+
+func NewCalculator(projectFilesResolver projectFilesResolver) *Calculator {
+	return &Calculator{
+		projectFilesResolver: projectFilesResolver,
+		scanner:              deepscan.NewSearcher(),
+	}
+}
+
+// Calculate will compute Robert Martin's package metrics for every spec component:
+//   - Ca (afferent coupling) - count of other components, that import this component
+//   - Ce (efferent coupling) - count of other components, imported by this component
+//   - I (instability) - Ce / (Ca + Ce)
+//   - A (abstractness) - interfaces / all declared types (from deepscan type info)
+//   - D (distance from the main sequence) - |A + I - 1|
+//
+// Coupling is calculated from real project imports, not from spec rules.
+func (c *Calculator) Calculate(ctx context.Context, spec arch.Spec) ([]models.ComponentMetrics, error) {
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	stats := make(map[string]*componentStats, len(spec.Components))
+	for _, cmp := range spec.Components {
+		stats[cmp.Name.Value] = &componentStats{
+			afferent: map[string]struct{}{},
+			efferent: map[string]struct{}{},
+		}
+	}
+
+	packageComponents := make(map[string]string, len(projectFiles))
+	for _, hold := range projectFiles {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		packageComponents[filepath.Dir(hold.File.Path)] = *hold.ComponentID
+	}
+
+	for _, hold := range projectFiles {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		cmpStats, ok := stats[*hold.ComponentID]
+		if !ok {
+			continue
+		}
+
+		for _, resolvedImport := range hold.File.Imports {
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				continue
+			}
+
+			packagePath := c.importToPackagePath(spec, resolvedImport.Name)
+			targetID, ok := packageComponents[packagePath]
+			if !ok || targetID == *hold.ComponentID {
+				continue
+			}
+
+			cmpStats.efferent[targetID] = struct{}{}
+			if targetStats, ok := stats[targetID]; ok {
+				targetStats.afferent[*hold.ComponentID] = struct{}{}
+			}
+		}
+	}
+
+	for packagePath, componentID := range packageComponents {
+		cmpStats, ok := stats[componentID]
+		if !ok {
+			continue
+		}
+
+		declared, err := c.declaredTypes(packagePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed count types in '%s': %w", packagePath, err)
+		}
+
+		cmpStats.interfaces += declared.Interfaces
+		cmpStats.concreteTypes += declared.Concrete
+	}
+
+	results := make([]models.ComponentMetrics, 0, len(stats))
+	for name, cmpStats := range stats {
+		results = append(results, c.assembleMetrics(name, cmpStats))
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ComponentName < results[j].ComponentName
+	})
+
+	return results, nil
+}
+
+func (c *Calculator) assembleMetrics(name string, stats *componentStats) models.ComponentMetrics {
+	ca := len(stats.afferent)
+	ce := len(stats.efferent)
+
+	instability := 0.0
+	if ca+ce > 0 {
+		instability = float64(ce) / float64(ca+ce)
+	}
+
+	abstractness := 0.0
+	if stats.interfaces+stats.concreteTypes > 0 {
+		abstractness = float64(stats.interfaces) / float64(stats.interfaces+stats.concreteTypes)
+	}
+
+	return models.ComponentMetrics{
+		ComponentName: name,
+		Afferent:      ca,
+		Efferent:      ce,
+		Interfaces:    stats.interfaces,
+		ConcreteTypes: stats.concreteTypes,
+		Instability:   round(instability),
+		Abstractness:  round(abstractness),
+		Distance:      round(math.Abs(abstractness + instability - 1)),
+	}
+}
+
+// importToPackagePath transform project import to abs package path, example:
+//
+//	example.com/neo/project/internal/a -> /home/neo/project/internal/a
+func (c *Calculator) importToPackagePath(spec arch.Spec, importPath string) string {
+	localPath := strings.TrimPrefix(importPath, spec.ModuleName.Value)
+	return filepath.Join(spec.RootDirectory.Value, filepath.FromSlash(localPath))
+}
+
+func (c *Calculator) declaredTypes(absPackagePath string) (deepscan.DeclaredTypes, error) {
+	criteria, err := deepscan.NewCriteria(
+		deepscan.WithPackagePath(absPackagePath),
+	)
+	if err != nil {
+		return deepscan.DeclaredTypes{}, fmt.Errorf("failed prepare scan criteria: %w", err)
+	}
+
+	declared, err := c.scanner.DeclaredTypes(criteria)
+	if err != nil {
+		return deepscan.DeclaredTypes{}, fmt.Errorf("scan failed: %w", err)
+	}
+
+	return declared, nil
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
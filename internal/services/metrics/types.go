@@ -0,0 +1,14 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+type (
+	projectFilesResolver interface {
+		ProjectFiles(ctx context.Context, spec arch.Spec) ([]models.FileHold, error)
+	}
+)
@@ -93,6 +93,34 @@ func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.
 	return results
 }
 
+// HoldPackage return component of single package (without scanning all
+// project files). Files count is not known, so when package match to
+// several components, packages count of component is used instead
+func (h *Holder) HoldPackage(packagePath string, components []arch.Component) *string {
+	var holder *matchedComponent
+
+	for _, component := range components {
+		if !componentMatchPackage(packagePath, component) {
+			continue
+		}
+
+		variant := matchedComponent{
+			id:         component.Name.Value,
+			filesCount: len(component.ResolvedPaths),
+		}
+
+		if holder == nil || compare(*holder, variant) {
+			holder = &variant
+		}
+	}
+
+	if holder == nil {
+		return nil
+	}
+
+	return &holder.id
+}
+
 // should return true if B better than A
 func compare(a, b matchedComponent) bool {
 	if a.id == b.id {
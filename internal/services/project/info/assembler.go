@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
@@ -41,7 +42,12 @@ func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (comm
 	}
 
 	// parse go.mod
-	moduleName, err := checkCmdExtractModuleName(goModFilePath)
+	goModFile, err := checkCmdParseGoModFile(goModFilePath)
+	if err != nil {
+		return common.Project{}, fmt.Errorf("failed get module name: can`t parse gomod: %w", err)
+	}
+
+	moduleName, err := checkCmdExtractModuleName(goModFile)
 	if err != nil {
 		return common.Project{}, fmt.Errorf("failed get module name: %w", err)
 	}
@@ -51,21 +57,58 @@ func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (comm
 		GoArchFilePath: goArchFilePath,
 		GoModFilePath:  goModFilePath,
 		ModuleName:     moduleName,
+		GoModules:      extractGoModules(goModFile, goModFilePath),
 	}, nil
 }
 
-func checkCmdExtractModuleName(goModPath string) (string, error) {
-	goModFile, err := checkCmdParseGoModFile(goModPath)
-	if err != nil {
-		return "", fmt.Errorf("can`t parse gomod: %w", err)
+func checkCmdExtractModuleName(goModFile *modfile.File) (string, error) {
+	if goModFile.Module == nil || goModFile.Module.Mod.Path == "" {
+		return "", fmt.Errorf("%s should contain module name in 'module'", models.DefaultGoModFileName)
 	}
 
-	moduleName := goModFile.Module.Mod.Path
-	if moduleName == "" {
-		return "", fmt.Errorf("%s should contain module name in 'module'", models.DefaultGoModFileName)
+	return goModFile.Module.Mod.Path, nil
+}
+
+func extractGoModules(goModFile *modfile.File, goModPath string) []common.GoModule {
+	modules := make([]common.GoModule, 0, len(goModFile.Require))
+
+	for _, require := range goModFile.Require {
+		module := common.GoModule{
+			Path:      require.Mod.Path,
+			Version:   require.Mod.Version,
+			Indirect:  require.Indirect,
+			Reference: goModReference(goModPath, require.Syntax),
+		}
+
+		for _, replace := range goModFile.Replace {
+			if replace.Old.Path != require.Mod.Path {
+				continue
+			}
+
+			if replace.Old.Version != "" && replace.Old.Version != require.Mod.Version {
+				continue
+			}
+
+			module.Replace = &common.GoModuleReplace{
+				Path:      replace.New.Path,
+				Version:   replace.New.Version,
+				Local:     modfile.IsDirectoryPath(replace.New.Path),
+				Reference: goModReference(goModPath, replace.Syntax),
+			}
+		}
+
+		modules = append(modules, module)
 	}
 
-	return moduleName, nil
+	return modules
+}
+
+func goModReference(goModPath string, line *modfile.Line) common.Reference {
+	if line == nil {
+		return common.NewEmptyReference()
+	}
+
+	return common.NewReferenceSingleLine(goModPath, line.Start.Line, line.Start.LineRune)
 }
 
 func checkCmdParseGoModFile(path string) (*modfile.File, error) {
@@ -74,7 +117,14 @@ func checkCmdParseGoModFile(path string) (*modfile.File, error) {
 		return nil, fmt.Errorf("failed to read '%s': %w", path, err)
 	}
 
-	mod, err := modfile.ParseLax(path, file, nil)
+	// strict parser is needed for replace directives (lax parser
+	// ignore them), but it fails on directives unknown to x/mod
+	mod, err := modfile.Parse(path, file, nil)
+	if err == nil {
+		return mod, nil
+	}
+
+	mod, err = modfile.ParseLax(path, file, nil)
 	if err != nil {
 		return nil, fmt.Errorf("modfile parseLax failed '%s': %w", path, err)
 	}
@@ -83,6 +133,27 @@ func checkCmdParseGoModFile(path string) (*modfile.File, error) {
 }
 
 func resolveArchPath(projectPath, archFilePath string) (string, error) {
+	if archFilePath == models.DefaultArchFileName {
+		// default arch file can be in any supported format
+		found := make([]string, 0, 1)
+		for _, fileName := range models.DefaultArchFileNames {
+			if _, err := os.Stat(filepath.Join(projectPath, fileName)); err == nil {
+				found = append(found, fileName)
+			}
+		}
+
+		if len(found) > 1 {
+			return "", fmt.Errorf("found several arch files [%s] in '%s', specify one of them with '--arch-file' flag",
+				strings.Join(found, ", "),
+				projectPath,
+			)
+		}
+
+		if len(found) == 1 {
+			return filepath.Join(projectPath, found[0]), nil
+		}
+	}
+
 	if filepath.IsAbs(archFilePath) {
 		return checkArchFile(archFilePath)
 	}
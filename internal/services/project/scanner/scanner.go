@@ -9,7 +9,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
@@ -32,21 +34,44 @@ type (
 	}
 )
 
+var (
+	stdPackagesOnce sync.Once
+	stdPackages     map[string]struct{}
+)
+
 func NewScanner() *Scanner {
-	scanner := &Scanner{
-		stdPackages: make(map[string]struct{}, 255),
+	return &Scanner{
+		stdPackages: loadStdPackages(),
 	}
+}
 
-	stdPackages, err := packages.Load(nil, "std")
-	if err != nil {
-		panic(fmt.Errorf("failed load std packages"))
-	}
+// loadStdPackages is slow, so std list is loaded only once
+// and shared between all scanners
+func loadStdPackages() map[string]struct{} {
+	stdPackagesOnce.Do(func() {
+		loaded, err := packages.Load(nil, "std")
+		if err != nil {
+			panic(fmt.Errorf("failed load std packages"))
+		}
 
-	for _, stdPackage := range stdPackages {
-		scanner.stdPackages[stdPackage.ID] = struct{}{}
+		stdPackages = make(map[string]struct{}, len(loaded))
+		for _, stdPackage := range loaded {
+			stdPackages[stdPackage.ID] = struct{}{}
+		}
+	})
+
+	return stdPackages
+}
+
+// StdPackages return sorted import paths of all std packages
+func (r *Scanner) StdPackages() []string {
+	list := make([]string, 0, len(r.stdPackages))
+	for stdPackage := range r.stdPackages {
+		list = append(list, stdPackage)
 	}
 
-	return scanner
+	sort.Strings(list)
+	return list
 }
 
 func (r *Scanner) Scan(
@@ -109,19 +134,51 @@ func (r *Scanner) inScope(ctx *resolveContext, path string) bool {
 }
 
 func (r *Scanner) parse(ctx *resolveContext, path string) error {
-	fileAst, err := parser.ParseFile(ctx.tokenSet, path, nil, parser.ImportsOnly)
+	sourceCode, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read go source code at '%s': %w", path, err)
+	}
+
+	fileAst, err := parser.ParseFile(ctx.tokenSet, path, sourceCode, parser.ParseComments)
 	if err != nil {
 		return fmt.Errorf("failed to parse go source code at '%s': %w", path, err)
 	}
 
 	ctx.results = append(ctx.results, models.ProjectFile{
-		Path:    path,
-		Imports: r.extractImports(ctx, fileAst),
+		Path:       path,
+		Imports:    r.extractImports(ctx, fileAst),
+		Directives: r.extractDirectives(ctx, fileAst),
 	})
 
 	return nil
 }
 
+// extractDirectives find "//go:xxx" comments in file, only real
+// comments is checked, so strings and /* */ blocks is ignored
+func (r *Scanner) extractDirectives(ctx *resolveContext, fileAst *ast.File) []models.FileDirective {
+	directives := make([]models.FileDirective, 0)
+
+	for _, commentGroup := range fileAst.Comments {
+		for _, comment := range commentGroup.List {
+			if !strings.HasPrefix(comment.Text, "//go:") {
+				continue
+			}
+
+			name := strings.TrimPrefix(comment.Text, "//")
+			if end := strings.IndexAny(name, " \t\r"); end != -1 {
+				name = name[:end]
+			}
+
+			directives = append(directives, models.FileDirective{
+				Name:      name,
+				Reference: astUtil.PositionFromToken(ctx.tokenSet.Position(comment.Pos())),
+			})
+		}
+	}
+
+	return directives
+}
+
 func (r *Scanner) extractImports(ctx *resolveContext, fileAst *ast.File) []models.ResolvedImport {
 	imports := make([]models.ResolvedImport, 0)
 
@@ -129,7 +186,7 @@ func (r *Scanner) extractImports(ctx *resolveContext, fileAst *ast.File) []model
 		importPath := strings.Trim(goImport.Path.Value, "\"")
 		imports = append(imports, models.ResolvedImport{
 			Name:       importPath,
-			ImportType: r.getImportType(ctx, importPath),
+			ImportType: r.ImportType(ctx.moduleName, importPath),
 			Reference:  astUtil.PositionFromToken(ctx.tokenSet.Position(goImport.Pos())),
 		})
 	}
@@ -137,14 +194,17 @@ func (r *Scanner) extractImports(ctx *resolveContext, fileAst *ast.File) []model
 	return imports
 }
 
-func (r *Scanner) getImportType(ctx *resolveContext, importPath string) models.ImportType {
+// ImportType classify import path of project module. All other
+// tools (like go/analysis analyzer) should use it, so imports
+// is always checked in same way as in `go-arch-lint check`
+func (r *Scanner) ImportType(moduleName string, importPath string) models.ImportType {
 	if _, ok := r.stdPackages[importPath]; ok {
 		return models.ImportTypeStdLib
 	}
 
 	// We can't use a straight prefix match here because the module name could be a substring of the import path.
 	// For example, if the module name is "example.com/foo/bar", we do not want to match "example.com/foo/bar-utils"
-	if importPath == ctx.moduleName || strings.HasPrefix(importPath, ctx.moduleName+"/") {
+	if importPath == moduleName || strings.HasPrefix(importPath, moduleName+"/") {
 		return models.ImportTypeProject
 	}
 
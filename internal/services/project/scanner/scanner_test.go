@@ -0,0 +1,46 @@
+package scanner
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanner_extractDirectives(t *testing.T) {
+	const source = `package main
+
+import _ "embed"
+
+//go:embed config.yml
+var config []byte
+
+/*
+//go:linkname inBlock runtime.nanotime
+*/
+
+const inString = ` + "`" + `
+//go:linkname inString runtime.nanotime
+` + "`" + `
+
+	//go:generate echo indented
+func main() {}
+`
+
+	ctx := &resolveContext{tokenSet: token.NewFileSet()}
+	fileAst, err := parser.ParseFile(ctx.tokenSet, "main.go", source, parser.ParseComments)
+	require.NoError(t, err)
+
+	directives := (&Scanner{}).extractDirectives(ctx, fileAst)
+	require.Len(t, directives, 2)
+
+	assert.Equal(t, "go:embed", directives[0].Name)
+	assert.Equal(t, 5, directives[0].Reference.Line)
+	assert.Equal(t, 1, directives[0].Reference.Column)
+
+	assert.Equal(t, "go:generate", directives[1].Name)
+	assert.Equal(t, 16, directives[1].Reference.Line)
+	assert.Equal(t, 2, directives[1].Reference.Column)
+}
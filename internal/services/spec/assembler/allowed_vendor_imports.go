@@ -36,10 +36,42 @@ func (aia *allowedVendorImportsAssembler) assemble(
 			continue
 		}
 
-		for _, vendorIn := range yamlVendor.Value.ImportPaths() {
+		for _, vendorIn := range vendorImportGlobs(yamlVendor.Value) {
 			list = append(list, common.NewReferable(vendorIn, yamlVendor.Reference))
 		}
 	}
 
 	return list, nil
 }
+
+// assembleForbidden return import globs of all forbidden vendors
+func (aia *allowedVendorImportsAssembler) assembleForbidden(
+	yamlDocument spec.Document,
+) []common.Referable[models.Glob] {
+	list := make([]common.Referable[models.Glob], 0)
+
+	for _, yamlVendor := range yamlDocument.Vendors() {
+		if !yamlVendor.Value.Forbidden().Value {
+			continue
+		}
+
+		for _, vendorIn := range vendorImportGlobs(yamlVendor.Value) {
+			list = append(list, common.NewReferable(vendorIn, yamlVendor.Value.Forbidden().Reference))
+		}
+	}
+
+	return list
+}
+
+// vendorImportGlobs return all import globs of vendor, all
+// packages of vendor modules is included into vendor
+func vendorImportGlobs(vendor spec.Vendor) []models.Glob {
+	globs := make([]models.Glob, 0)
+	globs = append(globs, vendor.ImportPaths()...)
+
+	for _, module := range vendor.Modules() {
+		globs = append(globs, module, module+"/**")
+	}
+
+	return globs
+}
@@ -31,6 +31,7 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 	spec := arch.Spec{
 		RootDirectory: common.NewEmptyReferable(prj.Directory),
 		ModuleName:    common.NewEmptyReferable(prj.ModuleName),
+		GoModules:     prj.GoModules,
 		Integrity: arch.Integrity{
 			DocumentNotices: []arch.Notice{},
 			Suggestions:     []arch.Notice{},
@@ -46,6 +47,12 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 		// only simple scheme validation errors
 		spec.Integrity.DocumentNotices = append(spec.Integrity.DocumentNotices, schemeNotices...)
 	} else {
+		// templates should be expanded before validation, because
+		// validator know nothing about template variables
+		var templateNotices []arch.Notice
+		document, templateNotices = newTemplatesExpander(prj.Directory).expand(document)
+		spec.Integrity.DocumentNotices = append(spec.Integrity.DocumentNotices, templateNotices...)
+
 		// if scheme is ok, need check arch errors
 		advancedErrors := sa.validator.Validate(document, prj.Directory)
 		spec.Integrity.DocumentNotices = append(spec.Integrity.DocumentNotices, advancedErrors...)
@@ -64,6 +71,7 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 	assembler := newSpecCompositeAssembler([]assembler{
 		newComponentsAssembler(
 			resolver,
+			newCodeOwners(prj.Directory),
 			newAllowedProjectImportsAssembler(
 				prj.Directory,
 				resolver,
@@ -75,6 +83,7 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 		newExcludeAssembler(resolver),
 		newExcludeFilesMatcherAssembler(),
 		newAllowAssembler(),
+		newVendorsAssembler(),
 		newWorkdirAssembler(),
 	})
 
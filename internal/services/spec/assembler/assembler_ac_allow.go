@@ -16,7 +16,10 @@ func (efa *allowAssembler) assemble(spec *arch.Spec, document spec.Document) err
 	spec.Allow = arch.Allow{
 		DepOnAnyVendor:           document.Options().IsDependOnAnyVendor(),
 		DeepScan:                 document.Options().DeepScan(),
+		DeepScanDepth:            document.Options().DeepScanDepth(),
+		AllChecks:                document.Options().AllChecks(),
 		IgnoreNotFoundComponents: document.Options().IgnoreNotFoundComponents(),
+		LowLevelFeatures:         document.Options().LowLevelFeatures(),
 	}
 
 	return nil
@@ -13,6 +13,7 @@ import (
 type (
 	componentsAssembler struct {
 		resolver                       *resolver
+		codeOwners                     *codeOwners
 		allowedProjectImportsAssembler *allowedProjectImportsAssembler
 		allowedVendorImportsAssembler  *allowedVendorImportsAssembler
 	}
@@ -20,11 +21,13 @@ type (
 
 func newComponentsAssembler(
 	resolver *resolver,
+	codeOwners *codeOwners,
 	allowedProjectImportsAssembler *allowedProjectImportsAssembler,
 	allowedVendorImportsAssembler *allowedVendorImportsAssembler,
 ) *componentsAssembler {
 	return &componentsAssembler{
 		resolver:                       resolver,
+		codeOwners:                     codeOwners,
 		allowedProjectImportsAssembler: allowedProjectImportsAssembler,
 		allowedVendorImportsAssembler:  allowedVendorImportsAssembler,
 	}
@@ -52,27 +55,38 @@ func (m *componentsAssembler) assembleComponent(
 
 	mayDependOn := make([]common.Referable[string], 0)
 	canUse := make([]common.Referable[string], 0)
+	allowedFeatures := make([]common.Referable[string], 0)
 	deepScan := yamlDocument.Options().DeepScan()
+	visibility := yamlComponent.Value.Visibility()
 
 	if hasDeps {
 		mayDependOn = append(mayDependOn, depMeta.Value.MayDependOn()...)
 		canUse = append(canUse, depMeta.Value.CanUse()...)
+		allowedFeatures = append(allowedFeatures, depMeta.Value.AllowedFeatures()...)
 		deepScan = depMeta.Value.DeepScan()
 	}
 
 	cmp := arch.Component{
-		Name:        common.NewReferable(yamlName, yamlComponent.Reference),
-		MayDependOn: mayDependOn,
-		CanUse:      canUse,
-		DeepScan:    deepScan,
+		Name:            common.NewReferable(yamlName, yamlComponent.Reference),
+		MayDependOn:     mayDependOn,
+		CanUse:          canUse,
+		AllowedFeatures: allowedFeatures,
+		DeepScan:        deepScan,
+		Private:         common.NewReferable(visibility.Value == models.VisibilityPrivate, visibility.Reference),
+		Description:     yamlComponent.Value.Description(),
+		Tags:            yamlComponent.Value.Tags(),
 	}
 
 	type enricher func() error
 	enrichers := []enricher{
 		func() error { return m.enrichWithFlags(&cmp, yamlComponent, hasDeps, depMeta.Value) },
 		func() error { return m.enrichWithResolvedPaths(&cmp, yamlDocument, yamlName, yamlComponent) },
+		func() error { return m.enrichWithOwner(&cmp, yamlComponent) },
 		func() error { return m.enrichWithProjectImports(&cmp, yamlComponent, yamlDocument, mayDependOn) },
+		func() error { return m.enrichWithDeniedImports(&cmp, yamlDocument) },
 		func() error { return m.enrichWithVendorGlobs(&cmp, yamlDocument, canUse) },
+		func() error { return m.enrichWithModules(&cmp, hasDeps, depMeta.Value) },
+		func() error { return m.enrichWithForbiddenStd(&cmp, yamlDocument, hasDeps, depMeta.Value) },
 	}
 
 	for _, enrich := range enrichers {
@@ -99,6 +113,10 @@ func (m *componentsAssembler) enrichWithFlags(
 		cmp.SpecialFlags = arch.SpecialFlags{
 			AllowAllProjectDeps: depMeta.AnyProjectDeps(),
 			AllowAllVendorDeps:  depMeta.AnyVendorDeps(),
+			RestrictModules: common.NewReferable(
+				depMeta.AllowModules().Value != nil,
+				depMeta.AllowModules().Reference,
+			),
 		}
 		return nil
 	}
@@ -106,6 +124,7 @@ func (m *componentsAssembler) enrichWithFlags(
 	cmp.SpecialFlags = arch.SpecialFlags{
 		AllowAllProjectDeps: common.NewReferable(false, yamlComponent.Reference),
 		AllowAllVendorDeps:  common.NewReferable(false, yamlComponent.Reference),
+		RestrictModules:     common.NewReferable(false, yamlComponent.Reference),
 	}
 
 	return nil
@@ -142,6 +161,27 @@ func (m *componentsAssembler) enrichWithResolvedPaths(
 	return nil
 }
 
+// enrichWithOwner use owner from spec, or find it in CODEOWNERS
+// file, by first component package, that has owner
+func (m *componentsAssembler) enrichWithOwner(
+	cmp *arch.Component,
+	yamlComponent common.Referable[spec.Component],
+) error {
+	cmp.Owner = yamlComponent.Value.Owner()
+	if cmp.Owner.Value != "" {
+		return nil
+	}
+
+	for _, resolvedPath := range cmp.ResolvedPaths {
+		if owner, found := m.codeOwners.owner(resolvedPath.Value.LocalPath); found {
+			cmp.Owner = owner
+			return nil
+		}
+	}
+
+	return nil
+}
+
 func (m *componentsAssembler) enrichWithProjectImports(
 	cmp *arch.Component,
 	yamlComponent common.Referable[spec.Component],
@@ -157,6 +197,71 @@ func (m *componentsAssembler) enrichWithProjectImports(
 	return nil
 }
 
+// enrichWithDeniedImports collect packages of all components, that
+// restrict importers with allowedImporters, and not allow this component.
+// Packages is matched by component paths, checkers keep only packages,
+// that actually held by restricting component (see checker.HoldDeniedImports)
+func (m *componentsAssembler) enrichWithDeniedImports(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+) error {
+	cmp.DeniedProjectImports = make([]common.Referable[arch.DeniedImport], 0)
+
+	ownPaths := make(map[string]struct{})
+	for _, resolvedPath := range cmp.ResolvedPaths {
+		ownPaths[resolvedPath.Value.ImportPath] = struct{}{}
+	}
+
+	for _, name := range sortedKeys(yamlDocument.Components()) {
+		if name == cmp.Name.Value {
+			continue
+		}
+
+		importers := yamlDocument.Components()[name].Value.AllowedImporters()
+		if importers.Value == nil || containsName(importers.Value, cmp.Name.Value) {
+			continue
+		}
+
+		for _, componentIn := range yamlDocument.Components()[name].Value.RelativePaths() {
+			resolved, err := m.resolver.resolveLocalGlobPath(
+				path.Clean(fmt.Sprintf("%s/%s",
+					yamlDocument.WorkingDirectory().Value,
+					string(componentIn),
+				)),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to resolve component '%s' path '%s': %w", name, componentIn, err)
+			}
+
+			for _, resolvedPath := range resolved {
+				if _, own := ownPaths[resolvedPath.ImportPath]; own {
+					continue
+				}
+
+				cmp.DeniedProjectImports = append(cmp.DeniedProjectImports, common.NewReferable(
+					arch.DeniedImport{
+						ComponentName: name,
+						ResolvedPath:  resolvedPath,
+					},
+					importers.Reference,
+				))
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsName(names []common.Referable[string], name string) bool {
+	for _, known := range names {
+		if known.Value == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (m *componentsAssembler) enrichWithVendorGlobs(
 	cmp *arch.Component,
 	yamlDocument spec.Document,
@@ -168,5 +273,44 @@ func (m *componentsAssembler) enrichWithVendorGlobs(
 	}
 
 	cmp.AllowedVendorGlobs = vendorGlobs
+	cmp.ForbiddenVendorGlobs = m.allowedVendorImportsAssembler.assembleForbidden(yamlDocument)
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithModules(
+	cmp *arch.Component,
+	hasDeps bool,
+	depMeta spec.DependencyRule,
+) error {
+	cmp.AllowedModules = make([]common.Referable[models.Glob], 0)
+	if !hasDeps {
+		return nil
+	}
+
+	for _, module := range depMeta.AllowModules().Value {
+		cmp.AllowedModules = append(cmp.AllowedModules, common.NewReferable(models.Glob(module.Value), module.Reference))
+	}
+
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithForbiddenStd(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	hasDeps bool,
+	depMeta spec.DependencyRule,
+) error {
+	cannotUseStd := make([]common.Referable[string], 0)
+	cannotUseStd = append(cannotUseStd, yamlDocument.CannotUseStd()...)
+
+	if hasDeps {
+		cannotUseStd = append(cannotUseStd, depMeta.CannotUseStd()...)
+	}
+
+	cmp.ForbiddenStdGlobs = make([]common.Referable[models.Glob], 0, len(cannotUseStd))
+	for _, stdGlob := range cannotUseStd {
+		cmp.ForbiddenStdGlobs = append(cmp.ForbiddenStdGlobs, common.NewReferable(models.Glob(stdGlob.Value), stdGlob.Reference))
+	}
+
 	return nil
 }
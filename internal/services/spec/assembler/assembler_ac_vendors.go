@@ -0,0 +1,44 @@
+package assembler
+
+import (
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type vendorsAssembler struct {
+}
+
+func newVendorsAssembler() *vendorsAssembler {
+	return &vendorsAssembler{}
+}
+
+func (va *vendorsAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	names := make([]string, 0, len(document.Vendors()))
+	for name := range document.Vendors() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		yamlVendor := document.Vendors()[name]
+		if len(yamlVendor.Value.Modules()) == 0 {
+			// go.mod rules is applied only to modules
+			continue
+		}
+
+		spec.Vendors = append(spec.Vendors, arch.Vendor{
+			Name:              common.NewReferable(name, yamlVendor.Reference),
+			Modules:           wrap[models.Glob](yamlVendor.Reference, yamlVendor.Value.Modules()),
+			Version:           yamlVendor.Value.Version(),
+			Forbidden:         yamlVendor.Value.Forbidden(),
+			AllowLocalReplace: yamlVendor.Value.AllowLocalReplace(),
+		})
+	}
+
+	return nil
+}
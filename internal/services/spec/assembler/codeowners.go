@@ -0,0 +1,129 @@
+package assembler
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+// codeOwnersLocations is places, where CODEOWNERS file is searched
+// (relative to project directory), first found file is used
+var codeOwnersLocations = []string{
+	"CODEOWNERS",
+	".github/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+	"docs/CODEOWNERS",
+}
+
+type (
+	// codeOwners is parsed CODEOWNERS file, used for finding
+	// component owner, when it's not defined in spec
+	codeOwners struct {
+		rules []codeOwnersRule
+	}
+
+	codeOwnersRule struct {
+		matcher *regexp.Regexp
+		owner   common.Referable[string]
+	}
+)
+
+// newCodeOwners will parse CODEOWNERS file in project, when file not
+// exist (or can't be read) all components will be without owner
+func newCodeOwners(projectDirectory string) *codeOwners {
+	owners := &codeOwners{
+		rules: []codeOwnersRule{},
+	}
+
+	for _, location := range codeOwnersLocations {
+		filePath := filepath.Join(projectDirectory, filepath.FromSlash(location))
+		file, err := os.Open(filePath)
+		if err != nil {
+			continue
+		}
+
+		owners.parse(filePath, bufio.NewScanner(file))
+		_ = file.Close()
+		return owners
+	}
+
+	return owners
+}
+
+func (co *codeOwners) parse(filePath string, scanner *bufio.Scanner) {
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, "[") {
+			// empty line, comment or gitlab section
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			// pattern without owners
+			continue
+		}
+
+		co.rules = append(co.rules, codeOwnersRule{
+			matcher: codeOwnersMatcher(fields[0]),
+			owner:   common.NewReferable(fields[1], common.NewReferenceSingleLine(filePath, line, 0)),
+		})
+	}
+}
+
+// owner of directory (relative to project directory), last matched rule
+// wins, same as in git hosting. Only first owner of rule is used
+func (co *codeOwners) owner(localPath string) (common.Referable[string], bool) {
+	localPath = strings.Trim(filepath.ToSlash(localPath), "/")
+
+	for ind := len(co.rules) - 1; ind >= 0; ind-- {
+		if co.rules[ind].matcher.MatchString(localPath) {
+			return co.rules[ind].owner, true
+		}
+	}
+
+	return common.Referable[string]{}, false
+}
+
+// codeOwnersMatcher convert gitignore-like pattern to directory matcher,
+// pattern also match all subdirectories of matched directory:
+//
+//	/internal/   -> internal, internal/a, internal/a/b
+//	billing      -> billing, internal/billing, internal/billing/a
+//	internal/*   -> internal/a, internal/a/b (but not internal)
+func codeOwnersMatcher(pattern string) *regexp.Regexp {
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	pattern = strings.Trim(pattern, "/")
+
+	expr := strings.Builder{}
+	for ind := 0; ind < len(pattern); ind++ {
+		switch {
+		case strings.HasPrefix(pattern[ind:], "**/"):
+			expr.WriteString("(.*/)?")
+			ind += 2
+		case strings.HasPrefix(pattern[ind:], "**"):
+			expr.WriteString(".*")
+			ind++
+		case pattern[ind] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[ind] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(pattern[ind])))
+		}
+	}
+
+	prefix := "^"
+	if !anchored {
+		prefix = "^(.*/)?"
+	}
+
+	return regexp.MustCompile(prefix + expr.String() + "(/.*)?$")
+}
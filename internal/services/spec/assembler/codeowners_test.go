@@ -0,0 +1,60 @@
+package assembler
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_codeOwnersMatcher(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "*", path: "internal/app", want: true},
+		{pattern: "/internal/", path: "internal", want: true},
+		{pattern: "/internal/", path: "internal/app/http", want: true},
+		{pattern: "/internal/", path: "cmd/internal", want: false},
+		{pattern: "billing", path: "billing", want: true},
+		{pattern: "billing", path: "internal/billing/api", want: true},
+		{pattern: "billing", path: "internal/billing2", want: false},
+		{pattern: "internal/*", path: "internal/app", want: true},
+		{pattern: "internal/*", path: "internal", want: false},
+		{pattern: "/internal/**/db", path: "internal/storage/pg/db", want: true},
+		{pattern: "/internal/**/db", path: "internal/db", want: true},
+		{pattern: "/internal/app?", path: "internal/app2", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, codeOwnersMatcher(tt.pattern).MatchString(tt.path))
+		})
+	}
+}
+
+func Test_codeOwners_owner(t *testing.T) {
+	owners := &codeOwners{}
+	owners.parse("CODEOWNERS", bufio.NewScanner(strings.NewReader(`
+# default owners
+*                  @acme/team-core
+
+[Payments]
+/internal/billing/ @acme/team-payments @acme/team-core
+/internal/billing/docs/
+`)))
+
+	owner, found := owners.owner("internal/billing/api/")
+	assert.True(t, found)
+	assert.Equal(t, "@acme/team-payments", owner.Value)
+	assert.Equal(t, 6, owner.Reference.Line)
+
+	owner, found = owners.owner("internal/app")
+	assert.True(t, found)
+	assert.Equal(t, "@acme/team-core", owner.Value)
+
+	_, found = (&codeOwners{}).owner("internal/app")
+	assert.False(t, found)
+}
@@ -0,0 +1,473 @@
+package assembler
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+// templateVariable is placeholder in component name and paths,
+// example: "{svc}-domain": { in: "services/{svc}/domain/**" }
+var templateVariable = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+type (
+	// templatesExpander will replace templated components (and deps rules)
+	// with concrete components, one for each set of variables values
+	// found in project directories
+	templatesExpander struct {
+		projectDirectory string
+	}
+
+	// templateBinding is variable values of one expanded component
+	templateBinding map[string]string
+
+	templateInstance struct {
+		name    string
+		binding templateBinding
+	}
+
+	// templatedDocument is document with already expanded templates
+	templatedDocument struct {
+		spec.Document
+
+		components       spec.Components
+		commonComponents []common.Referable[string]
+		dependencies     spec.Dependencies
+	}
+
+	templatedComponent struct {
+		spec.Component
+
+		relativePaths    []models.Glob
+		allowedImporters common.Referable[[]common.Referable[string]]
+	}
+
+	templatedDependencyRule struct {
+		spec.DependencyRule
+
+		mayDependOn []common.Referable[string]
+	}
+)
+
+func newTemplatesExpander(projectDirectory string) *templatesExpander {
+	return &templatesExpander{
+		projectDirectory: projectDirectory,
+	}
+}
+
+// expand return document with expanded templates, or
+// original document, when it not contain any templates
+func (te *templatesExpander) expand(document spec.Document) (spec.Document, []arch.Notice) {
+	notices := make([]arch.Notice, 0)
+	instances := make(map[spec.ComponentName][]templateInstance)
+	components := make(spec.Components)
+
+	for name, component := range document.Components() {
+		if !isTemplate(name) {
+			components[name] = component
+		}
+	}
+
+	for _, name := range sortedKeys(document.Components()) {
+		if !isTemplate(name) {
+			continue
+		}
+
+		// failed templates is expanded to nothing
+		instances[name] = []templateInstance{}
+
+		component := document.Components()[name]
+		bindings, err := te.discoverBindings(document, name, component.Value)
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    component.Reference,
+			})
+			continue
+		}
+
+		if len(bindings) == 0 && !document.Options().IgnoreNotFoundComponents().Value {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("not found directories for component template '%s'", name),
+				Ref:    component.Reference,
+			})
+			continue
+		}
+
+		for _, binding := range bindings {
+			instanceName := binding.apply(name)
+			if _, exist := components[instanceName]; exist {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("component '%s' (expanded from template '%s') already defined", instanceName, name),
+					Ref:    component.Reference,
+				})
+				continue
+			}
+
+			paths := make([]models.Glob, 0, len(component.Value.RelativePaths()))
+			for _, relativePath := range component.Value.RelativePaths() {
+				paths = append(paths, models.Glob(binding.apply(string(relativePath))))
+			}
+
+			components[instanceName] = common.NewReferable[spec.Component](
+				templatedComponent{
+					Component:        component.Value,
+					relativePaths:    paths,
+					allowedImporters: component.Value.AllowedImporters(),
+				},
+				component.Reference,
+			)
+
+			instances[name] = append(instances[name], templateInstance{
+				name:    instanceName,
+				binding: binding,
+			})
+		}
+	}
+
+	if len(instances) == 0 {
+		return document, notices
+	}
+
+	te.expandImporters(components, instances)
+
+	dependencies := make(spec.Dependencies)
+	for name, rule := range document.Dependencies() {
+		if !isTemplate(name) {
+			dependencies[name] = te.expandRule(rule, templateBinding{}, instances)
+		}
+	}
+
+	for _, name := range sortedKeys(document.Dependencies()) {
+		rule := document.Dependencies()[name]
+		if !isTemplate(name) {
+			continue
+		}
+
+		ruleInstances, exist := instances[name]
+		if !exist {
+			// not a component template, will be reported
+			// by validator as unknown component
+			dependencies[name] = rule
+			continue
+		}
+
+		for _, instance := range ruleInstances {
+			if _, exist := dependencies[instance.name]; exist {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("deps rule for component '%s' (expanded from template '%s') already defined", instance.name, name),
+					Ref:    rule.Reference,
+				})
+				continue
+			}
+
+			dependencies[instance.name] = te.expandRule(rule, instance.binding, instances)
+		}
+	}
+
+	return templatedDocument{
+		Document:         document,
+		components:       components,
+		commonComponents: expandNames(document.CommonComponents(), templateBinding{}, instances),
+		dependencies:     dependencies,
+	}, notices
+}
+
+// expandImporters will replace templated component names in allowedImporters
+// of all components, instance importers is matched with instance binding
+func (te *templatesExpander) expandImporters(
+	components spec.Components,
+	instances map[spec.ComponentName][]templateInstance,
+) {
+	bindings := make(map[spec.ComponentName]templateBinding)
+	for _, nameInstances := range instances {
+		for _, instance := range nameInstances {
+			bindings[instance.name] = instance.binding
+		}
+	}
+
+	for _, name := range sortedKeys(components) {
+		component := components[name]
+		importers := component.Value.AllowedImporters()
+		if importers.Value == nil {
+			continue
+		}
+
+		binding, exist := bindings[name]
+		if !exist {
+			binding = templateBinding{}
+		}
+
+		components[name] = common.NewReferable[spec.Component](
+			templatedComponent{
+				Component:     component.Value,
+				relativePaths: component.Value.RelativePaths(),
+				allowedImporters: common.NewReferable(
+					expandNames(importers.Value, binding, instances),
+					importers.Reference,
+				),
+			},
+			component.Reference,
+		)
+	}
+}
+
+func (te *templatesExpander) expandRule(
+	rule common.Referable[spec.DependencyRule],
+	binding templateBinding,
+	instances map[spec.ComponentName][]templateInstance,
+) common.Referable[spec.DependencyRule] {
+	return common.NewReferable[spec.DependencyRule](
+		templatedDependencyRule{
+			DependencyRule: rule.Value,
+			mayDependOn:    expandNames(rule.Value.MayDependOn(), binding, instances),
+		},
+		rule.Reference,
+	)
+}
+
+// discoverBindings will find all variables values, by matching
+// component paths with project directories
+func (te *templatesExpander) discoverBindings(
+	document spec.Document,
+	name spec.ComponentName,
+	component spec.Component,
+) ([]templateBinding, error) {
+	nameVariables := templateVariables(name)
+	baseDirectory := filepath.Join(te.projectDirectory, document.WorkingDirectory().Value)
+	found := make(map[string]templateBinding)
+	templated := false
+
+	for _, relativePath := range component.RelativePaths() {
+		localPath := string(relativePath)
+		if !isTemplate(localPath) {
+			continue
+		}
+
+		templated = true
+
+		if strings.Join(templateVariables(localPath), ",") != strings.Join(nameVariables, ",") {
+			return nil, fmt.Errorf("component template '%s' path '%s' should use same variables as component name", name, localPath)
+		}
+
+		bindings, err := te.discoverPathBindings(baseDirectory, localPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed discover component template '%s' path '%s': %w", name, localPath, err)
+		}
+
+		for _, binding := range bindings {
+			found[binding.apply(name)] = binding
+		}
+	}
+
+	if !templated {
+		return nil, fmt.Errorf("component template '%s' should use variables from name in at least one path", name)
+	}
+
+	bindings := make([]templateBinding, 0, len(found))
+	for _, instanceName := range sortedKeys(found) {
+		bindings = append(bindings, found[instanceName])
+	}
+
+	return bindings, nil
+}
+
+// discoverPathBindings will match path template with project directories,
+// only path segments, up to last templated segment is used for matching:
+//
+//	services/{svc}/domain/** -> services/* -> [services/a, services/b] -> [{svc: a}, {svc: b}]
+func (te *templatesExpander) discoverPathBindings(baseDirectory string, localPath string) ([]templateBinding, error) {
+	segments := strings.Split(path.Clean(localPath), "/")
+	lastTemplated := 0
+	for ind, segment := range segments {
+		if isTemplate(segment) {
+			lastTemplated = ind
+		}
+	}
+
+	segments = segments[:lastTemplated+1]
+	globSegments := make([]string, 0, len(segments))
+	regSegments := make([]string, 0, len(segments))
+	groupVariables := make([]string, 0)
+
+	for _, segment := range segments {
+		if strings.Contains(segment, "**") {
+			return nil, fmt.Errorf("recursive glob '**' is not supported before template variable")
+		}
+
+		globSegments = append(globSegments, templateVariable.ReplaceAllString(segment, "*"))
+
+		literals := templateVariable.Split(segment, -1)
+		variables := templateVariable.FindAllStringSubmatch(segment, -1)
+
+		regSegment := ""
+		for ind, literal := range literals {
+			regSegment += strings.ReplaceAll(regexp.QuoteMeta(literal), `\*`, `[^/]*`)
+
+			if ind < len(variables) {
+				regSegment += `([^/]+)`
+				groupVariables = append(groupVariables, variables[ind][1])
+			}
+		}
+
+		regSegments = append(regSegments, regSegment)
+	}
+
+	matcher, err := regexp.Compile("^" + strings.Join(regSegments, "/") + "$")
+	if err != nil {
+		return nil, fmt.Errorf("failed compile path matcher: %w", err)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(baseDirectory, filepath.FromSlash(strings.Join(globSegments, "/"))))
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob: %w", err)
+	}
+
+	bindings := make([]templateBinding, 0, len(matches))
+	for _, match := range matches {
+		if stat, err := os.Stat(match); err != nil || !stat.IsDir() {
+			continue
+		}
+
+		relativePath, err := filepath.Rel(baseDirectory, match)
+		if err != nil {
+			continue
+		}
+
+		groups := matcher.FindStringSubmatch(filepath.ToSlash(relativePath))
+		if groups == nil {
+			continue
+		}
+
+		binding := make(templateBinding)
+		consistent := true
+		for ind, variable := range groupVariables {
+			value := groups[ind+1]
+
+			// same variable can be used several times in path
+			if exist, defined := binding[variable]; defined && exist != value {
+				consistent = false
+				break
+			}
+
+			binding[variable] = value
+		}
+
+		if consistent {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings, nil
+}
+
+// expandNames will replace templated component names with expanded
+// instances, that match to current binding. Example:
+//
+//	{svc}-domain with binding {svc: a} -> [a-domain]
+//	{svc}-domain without binding -> [a-domain, b-domain]
+func expandNames(
+	names []common.Referable[string],
+	binding templateBinding,
+	instances map[spec.ComponentName][]templateInstance,
+) []common.Referable[string] {
+	expanded := make([]common.Referable[string], 0, len(names))
+
+	for _, name := range names {
+		nameInstances, exist := instances[name.Value]
+		if !exist {
+			// not templated, or unknown template (will be reported by validator)
+			expanded = append(expanded, name)
+			continue
+		}
+
+		for _, instance := range nameInstances {
+			if !binding.matches(instance.binding) {
+				continue
+			}
+
+			expanded = append(expanded, common.NewReferable(instance.name, name.Reference))
+		}
+	}
+
+	return expanded
+}
+
+func isTemplate(value string) bool {
+	return templateVariable.MatchString(value)
+}
+
+// templateVariables return sorted unique variables names used in value
+func templateVariables(value string) []string {
+	unique := make(map[string]struct{})
+	for _, match := range templateVariable.FindAllStringSubmatch(value, -1) {
+		unique[match[1]] = struct{}{}
+	}
+
+	return sortedKeys(unique)
+}
+
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func (b templateBinding) apply(value string) string {
+	return templateVariable.ReplaceAllStringFunc(value, func(placeholder string) string {
+		if actual, exist := b[placeholder[1:len(placeholder)-1]]; exist {
+			return actual
+		}
+
+		return placeholder
+	})
+}
+
+// matches check that both bindings have same values
+// for variables, defined in both of them
+func (b templateBinding) matches(another templateBinding) bool {
+	for variable, value := range b {
+		if anotherValue, exist := another[variable]; exist && anotherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d templatedDocument) Components() spec.Components {
+	return d.components
+}
+
+func (d templatedDocument) CommonComponents() []common.Referable[string] {
+	return d.commonComponents
+}
+
+func (d templatedDocument) Dependencies() spec.Dependencies {
+	return d.dependencies
+}
+
+func (c templatedComponent) RelativePaths() []models.Glob {
+	return c.relativePaths
+}
+
+func (c templatedComponent) AllowedImporters() common.Referable[[]common.Referable[string]] {
+	return c.allowedImporters
+}
+
+func (r templatedDependencyRule) MayDependOn() []common.Referable[string] {
+	return r.mayDependOn
+}
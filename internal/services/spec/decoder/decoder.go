@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"os"
 
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
@@ -15,36 +15,43 @@ import (
 type Decoder struct {
 	yamlReferenceResolver yamlSourceCodeReferenceResolver
 	jsonSchemaProvider    jsonSchemaProvider
+	archFormat            models.ArchFormat
 }
 
 func NewDecoder(
 	yamlReferenceResolver yamlSourceCodeReferenceResolver,
 	jsonSchemaProvider jsonSchemaProvider,
+	archFormat models.ArchFormat,
 ) *Decoder {
 	return &Decoder{
 		yamlReferenceResolver: yamlReferenceResolver,
 		jsonSchemaProvider:    jsonSchemaProvider,
+		archFormat:            archFormat,
 	}
 }
 
 func (sp *Decoder) Decode(archFile string) (spec.Document, []arch.Notice, error) {
-	sourceCode, err := os.ReadFile(archFile)
+	source, err := sp.readSource(archFile, sp.archFormat)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to provide source code of archfile: %w", err)
+		return nil, nil, err
 	}
 
 	// read only doc Version
-	documentVersion, err := sp.readVersion(sourceCode)
+	documentVersion, err := sp.readVersion(source.code)
 	if err != nil {
 		// invalid yaml document
 		return nil, nil, fmt.Errorf("failed to read 'version' from arch file: %w", err)
 	}
 
+	// composed document can be partial, all required
+	// fields will be checked after merge
+	composed := documentVersion == 3 && sp.isComposed(source.code)
+
 	// validate yaml scheme by version
-	schemeNotices := sp.jsonSchemeValidate(documentVersion, sourceCode, archFile)
+	schemeNotices := sp.jsonSchemeValidate(documentVersion, source, composed)
 
 	// try to read all document
-	document, err := sp.decodeDocument(documentVersion, sourceCode, archFile)
+	document, err := sp.decodeDocument(documentVersion, source)
 	if err != nil {
 		if len(schemeNotices) > 0 {
 			// document invalid, but yaml
@@ -52,14 +59,24 @@ func (sp *Decoder) Decode(archFile string) (spec.Document, []arch.Notice, error)
 		}
 
 		// invalid yaml document, or scheme validation failed
-		return nil, nil, fmt.Errorf("failed to parse arch file (yaml): %w", err)
+		return nil, nil, fmt.Errorf("failed to parse arch file (%s): %w", source.format, err)
+	}
+
+	if composed && len(schemeNotices) == 0 {
+		composeNotices, err := sp.compose(document.(*ArchV3), archFile, []string{archFile})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to compose arch file: %w", err)
+		}
+
+		schemeNotices = append(schemeNotices, composeNotices...)
 	}
 
+	document.postSetup()
 	return document, schemeNotices, nil
 }
 
-func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath string) (doc, error) {
-	reader := bytes.NewBuffer(sourceCode)
+func (sp *Decoder) decodeDocument(version int, source archSource) (doc, error) {
+	reader := bytes.NewBuffer(source.code)
 	decoder := yaml.NewDecoder(
 		reader,
 		yaml.DisallowDuplicateKey(),
@@ -67,7 +84,7 @@ func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath strin
 		yaml.Strict(),
 	)
 
-	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, filePath)
+	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, source)
 	document := sp.createEmptyDocumentBeVersion(version)
 
 	err := decoder.DecodeContext(decodeCtx, document)
@@ -75,7 +92,6 @@ func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath strin
 		return nil, err
 	}
 
-	document.postSetup()
 	return document, nil
 }
 
@@ -106,7 +122,7 @@ func (sp *Decoder) readVersion(sourceCode []byte) (int, error) {
 	return document.Version, nil
 }
 
-func (sp *Decoder) jsonSchemeValidate(schemeVersion int, sourceCode []byte, filePath string) []arch.Notice {
+func (sp *Decoder) jsonSchemeValidate(schemeVersion int, source archSource, partial bool) []arch.Notice {
 	jsonSchema, err := sp.jsonSchemaProvider.Provide(schemeVersion)
 	if err != nil {
 		return []arch.Notice{{
@@ -115,7 +131,17 @@ func (sp *Decoder) jsonSchemeValidate(schemeVersion int, sourceCode []byte, file
 		}}
 	}
 
-	jsonNotices, err := jsonSchemeValidate(jsonSchema, sourceCode)
+	if partial {
+		jsonSchema, err = partialJsonSchema(jsonSchema)
+		if err != nil {
+			return []arch.Notice{{
+				Notice: fmt.Errorf("failed to prepare json scheme for partial document: %w", err),
+				Ref:    common.NewEmptyReference(),
+			}}
+		}
+	}
+
+	jsonNotices, err := jsonSchemeValidate(jsonSchema, source.code)
 	if err != nil {
 		return []arch.Notice{{
 			Notice: fmt.Errorf("failed to validate arch file with json scheme: %w", err),
@@ -127,7 +153,11 @@ func (sp *Decoder) jsonSchemeValidate(schemeVersion int, sourceCode []byte, file
 	for _, jsonNotice := range jsonNotices {
 		schemeRef := common.NewEmptyReference()
 		if jsonNotice.yamlPath != nil {
-			schemeRef = sp.yamlReferenceResolver.Resolve(filePath, *jsonNotice.yamlPath)
+			schemeRef = sp.yamlReferenceResolver.ResolveSource(source.filePath, source.code, *jsonNotice.yamlPath)
+			if schemeRef.Valid {
+				line, column := source.translate(schemeRef.Line, schemeRef.Column)
+				schemeRef = common.NewReferenceSingleLine(schemeRef.File, line, column)
+			}
 		}
 
 		schemeNotices = append(schemeNotices, arch.Notice{
@@ -0,0 +1,308 @@
+package decoder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-yaml"
+)
+
+// composableVersion is first arch file version, that support
+// composition with 'extends' and 'include' sections
+const composableVersion = 3
+
+// isComposed check that document has 'extends' or 'include' section,
+// in this case it can be partial, and should be merged with other files
+func (sp *Decoder) isComposed(sourceCode []byte) bool {
+	type doc struct {
+		Extends interface{} `json:"extends"`
+		Include interface{} `json:"include"`
+	}
+
+	reader := bytes.NewBuffer(sourceCode)
+	decoder := yaml.NewDecoder(reader)
+	document := doc{}
+	err := decoder.Decode(&document)
+	if err != nil {
+		return false
+	}
+
+	return document.Extends != nil || document.Include != nil
+}
+
+// compose will decode all included and extended files
+// and merge them into document. Every merged value keep reference
+// to file, where it was defined.
+//
+// chain is list of files, that currently in composition (for cycles detection)
+func (sp *Decoder) compose(document *ArchV3, filePath string, chain []string) ([]arch.Notice, error) {
+	notices := make([]arch.Notice, 0)
+	currentDir := filepath.Dir(filePath)
+
+	// included files is equal in rights, so any
+	// value can be defined only in one of them
+	for _, include := range document.FInclude {
+		files, err := filepath.Glob(composedPath(currentDir, include.ref.Value))
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid include glob '%s': %w", include.ref.Value, err),
+				Ref:    include.ref.Reference,
+			})
+			continue
+		}
+
+		if len(files) == 0 {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("not found arch files for include '%s'", include.ref.Value),
+				Ref:    include.ref.Reference,
+			})
+			continue
+		}
+
+		sort.Strings(files)
+		for _, file := range files {
+			included, partNotices, err := sp.decodePart(file, include, chain)
+			if err != nil {
+				return nil, err
+			}
+
+			notices = append(notices, partNotices...)
+			if included == nil {
+				continue
+			}
+
+			notices = append(notices, mergeArchV3(document, included, false)...)
+		}
+	}
+
+	// extended file is base for current document,
+	// so current values will override base values
+	if document.FExtends.defined {
+		file := composedPath(currentDir, document.FExtends.ref.Value)
+		base, partNotices, err := sp.decodePart(file, document.FExtends, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		notices = append(notices, partNotices...)
+		if base != nil {
+			notices = append(notices, mergeArchV3(document, base, true)...)
+		}
+	}
+
+	if len(chain) == 1 && len(notices) == 0 {
+		notices = append(notices, sp.composedRequiredNotices(document)...)
+	}
+
+	return notices, nil
+}
+
+// decodePart will decode (and compose) one included or extended file.
+// All problems with part is returned as notices on referenced value
+// in parent file.
+func (sp *Decoder) decodePart(filePath string, parentRef ref[string], chain []string) (*ArchV3, []arch.Notice, error) {
+	notice := func(err error) []arch.Notice {
+		return []arch.Notice{{
+			Notice: err,
+			Ref:    parentRef.ref.Reference,
+		}}
+	}
+
+	filePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed get abs path from '%s': %w", filePath, err)
+	}
+
+	for _, composedFile := range chain {
+		if composedFile == filePath {
+			return nil, notice(fmt.Errorf("circular composition, file '%s' already in composition chain", parentRef.ref.Value)), nil
+		}
+	}
+
+	source, err := sp.readSource(filePath, models.ArchFormatAuto)
+	if err != nil {
+		return nil, notice(fmt.Errorf("failed to read arch file '%s': %w", parentRef.ref.Value, err)), nil
+	}
+
+	documentVersion, err := sp.readVersion(source.code)
+	if err != nil {
+		return nil, notice(fmt.Errorf("failed to read 'version' from arch file '%s': %w", parentRef.ref.Value, err)), nil
+	}
+
+	if documentVersion != composableVersion {
+		return nil, notice(fmt.Errorf("arch file '%s' should have version %d, for composition", parentRef.ref.Value, composableVersion)), nil
+	}
+
+	schemeNotices := sp.jsonSchemeValidate(documentVersion, source, true)
+	if len(schemeNotices) > 0 {
+		return nil, schemeNotices, nil
+	}
+
+	document, err := sp.decodeDocument(documentVersion, source)
+	if err != nil {
+		return nil, notice(fmt.Errorf("failed to parse arch file '%s' (%s): %w", parentRef.ref.Value, source.format, err)), nil
+	}
+
+	part := document.(*ArchV3)
+	notices, err := sp.compose(part, filePath, append(chain[:len(chain):len(chain)], filePath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return part, notices, nil
+}
+
+// composedRequiredNotices check required sections, that
+// is not validated by json scheme in partial documents
+func (sp *Decoder) composedRequiredNotices(document *ArchV3) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	if len(document.FComponents) == 0 {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'components' should be defined in arch file, or in extended/included files"),
+			Ref:    document.FVersion.ref.Reference,
+		})
+	}
+
+	if len(document.FDependencies) == 0 {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'deps' should be defined in arch file, or in extended/included files"),
+			Ref:    document.FVersion.ref.Reference,
+		})
+	}
+
+	return notices
+}
+
+// mergeArchV3 will merge src document into dst. When override is true
+// dst values has priority over src (extends), otherwise any value
+// defined in both documents is conflict (include)
+func mergeArchV3(dst *ArchV3, src *ArchV3, override bool) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	notices = append(notices, mergeRef(&dst.FWorkDir, src.FWorkDir, "workdir", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FDepOnAnyVendor, src.FAllow.FDepOnAnyVendor, "allow.depOnAnyVendor", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FDeepScan, src.FAllow.FDeepScan, "allow.deepScan", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FDeepScanDepth, src.FAllow.FDeepScanDepth, "allow.deepScanDepth", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FAllChecks, src.FAllow.FAllChecks, "allow.allChecks", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FIgnoreNotFoundComponents, src.FAllow.FIgnoreNotFoundComponents, "allow.ignoreNotFoundComponents", override)...)
+	notices = append(notices, mergeRef(&dst.FAllow.FLowLevelFeatures, src.FAllow.FLowLevelFeatures, "allow.lowLevelFeatures", override)...)
+
+	dst.FExclude = mergeRefList(dst.FExclude, src.FExclude)
+	dst.FExcludeFilesRegExp = mergeRefList(dst.FExcludeFilesRegExp, src.FExcludeFilesRegExp)
+	dst.FCommonVendors = mergeRefList(dst.FCommonVendors, src.FCommonVendors)
+	dst.FCannotUseStd = mergeRefList(dst.FCannotUseStd, src.FCannotUseStd)
+	dst.FCommonComponents = mergeRefList(dst.FCommonComponents, src.FCommonComponents)
+
+	var mapNotices []arch.Notice
+	dst.FVendors, mapNotices = mergeRefMap(dst.FVendors, src.FVendors, "vendor", override)
+	notices = append(notices, mapNotices...)
+
+	dst.FComponents, mapNotices = mergeRefMap(dst.FComponents, src.FComponents, "component", override)
+	notices = append(notices, mapNotices...)
+
+	dst.FDependencies, mapNotices = mergeRefMap(dst.FDependencies, src.FDependencies, "deps rule for component", override)
+	notices = append(notices, mapNotices...)
+
+	return notices
+}
+
+func mergeRef[T any](dst *ref[T], src ref[T], name string, override bool) []arch.Notice {
+	if !src.defined {
+		return nil
+	}
+
+	if !dst.defined {
+		*dst = src
+		return nil
+	}
+
+	if override {
+		return nil
+	}
+
+	return []arch.Notice{{
+		Notice: fmt.Errorf("'%s' already defined in '%s'", name, dst.ref.Reference),
+		Ref:    src.ref.Reference,
+	}}
+}
+
+func mergeRefList[T comparable](dst []ref[T], src []ref[T]) []ref[T] {
+	for _, srcRef := range src {
+		exist := false
+		for _, dstRef := range dst {
+			if dstRef.ref.Value == srcRef.ref.Value {
+				exist = true
+				break
+			}
+		}
+
+		if !exist {
+			dst = append(dst, srcRef)
+		}
+	}
+
+	return dst
+}
+
+func mergeRefMap[K ~string, V any](dst map[K]ref[V], src map[K]ref[V], kind string, override bool) (map[K]ref[V], []arch.Notice) {
+	if dst == nil {
+		dst = make(map[K]ref[V], len(src))
+	}
+
+	names := make([]K, 0, len(src))
+	for name := range src {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+
+	notices := make([]arch.Notice, 0)
+	for _, name := range names {
+		existRef, exist := dst[name]
+		if !exist {
+			dst[name] = src[name]
+			continue
+		}
+
+		if override {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("%s '%s' already defined in '%s'", kind, name, existRef.ref.Reference),
+			Ref:    src[name].ref.Reference,
+		})
+	}
+
+	return dst, notices
+}
+
+// partialJsonSchema return copy of json schema, where only
+// version is required, all other sections can be defined
+// in another composed files
+func partialJsonSchema(jsonSchema []byte) ([]byte, error) {
+	schema := make(map[string]interface{})
+	err := json.Unmarshal(jsonSchema, &schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed unmarshal json schema: %w", err)
+	}
+
+	schema["required"] = []string{"version"}
+	return json.Marshal(schema)
+}
+
+func composedPath(currentDir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(currentDir, path)
+}
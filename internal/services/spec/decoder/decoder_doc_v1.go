@@ -75,6 +75,11 @@ func (a *ArchV1) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV1) CannotUseStd() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
 func (a *ArchV1) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -107,22 +112,80 @@ func (a ArchV1Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV1Allow) DeepScanDepth() common.Referable[int] {
+	return common.NewEmptyReferable(models.DefaultDeepScanDepth)
+}
+
+func (a ArchV1Allow) AllChecks() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
 func (a ArchV1Allow) IgnoreNotFoundComponents() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV1Allow) LowLevelFeatures() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(true)
+}
+
 // --
 
 func (a ArchV1Vendor) ImportPaths() []models.Glob {
 	return []models.Glob{models.Glob(a.FImportPath)}
 }
 
+func (a ArchV1Vendor) Modules() []models.Glob {
+	// supported from v3+
+	return []models.Glob{}
+}
+
+func (a ArchV1Vendor) Version() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV1Vendor) Forbidden() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV1Vendor) AllowLocalReplace() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(false)
+}
+
 // --
 
 func (a ArchV1Component) RelativePaths() []models.Glob {
 	return []models.Glob{models.Glob(a.FLocalPath)}
 }
 
+func (a ArchV1Component) Visibility() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable(models.VisibilityPublic)
+}
+
+func (a ArchV1Component) AllowedImporters() common.Referable[[]common.Referable[string]] {
+	// supported from v3+
+	return common.NewEmptyReferable[[]common.Referable[string]](nil)
+}
+
+func (a ArchV1Component) Owner() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV1Component) Description() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV1Component) Tags() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
 // --
 
 func (a ArchV1Rule) MayDependOn() []common.Referable[string] {
@@ -144,3 +207,18 @@ func (a ArchV1Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV1Rule) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
+
+func (a ArchV1Rule) AllowModules() common.Referable[[]common.Referable[string]] {
+	// supported from v3+
+	return common.NewEmptyReferable[[]common.Referable[string]](nil)
+}
+
+func (a ArchV1Rule) CannotUseStd() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) AllowedFeatures() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
@@ -85,6 +85,11 @@ func (a *ArchV2) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV2) CannotUseStd() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
 func (a *ArchV2) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -117,10 +122,23 @@ func (a ArchV2Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV2Allow) DeepScanDepth() common.Referable[int] {
+	return common.NewEmptyReferable(models.DefaultDeepScanDepth)
+}
+
+func (a ArchV2Allow) AllChecks() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
 func (a ArchV2Allow) IgnoreNotFoundComponents() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV2Allow) LowLevelFeatures() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(true)
+}
+
 // --
 
 func (a ArchV2Vendor) ImportPaths() []models.Glob {
@@ -133,6 +151,26 @@ func (a ArchV2Vendor) ImportPaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV2Vendor) Modules() []models.Glob {
+	// supported from v3+
+	return []models.Glob{}
+}
+
+func (a ArchV2Vendor) Version() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV2Vendor) Forbidden() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV2Vendor) AllowLocalReplace() common.Referable[bool] {
+	// supported from v3+
+	return common.NewEmptyReferable(false)
+}
+
 // --
 
 func (a ArchV2Component) RelativePaths() []models.Glob {
@@ -145,6 +183,31 @@ func (a ArchV2Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV2Component) Visibility() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable(models.VisibilityPublic)
+}
+
+func (a ArchV2Component) AllowedImporters() common.Referable[[]common.Referable[string]] {
+	// supported from v3+
+	return common.NewEmptyReferable[[]common.Referable[string]](nil)
+}
+
+func (a ArchV2Component) Owner() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV2Component) Description() common.Referable[string] {
+	// supported from v3+
+	return common.NewEmptyReferable("")
+}
+
+func (a ArchV2Component) Tags() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
 // --
 
 func (a ArchV2Rule) MayDependOn() []common.Referable[string] {
@@ -166,3 +229,18 @@ func (a ArchV2Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV2Rule) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
+
+func (a ArchV2Rule) AllowModules() common.Referable[[]common.Referable[string]] {
+	// supported from v3+
+	return common.NewEmptyReferable[[]common.Referable[string]](nil)
+}
+
+func (a ArchV2Rule) CannotUseStd() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) AllowedFeatures() []common.Referable[string] {
+	// supported from v3+
+	return []common.Referable[string]{}
+}
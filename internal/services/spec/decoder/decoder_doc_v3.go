@@ -9,14 +9,26 @@ import (
 type (
 	// ArchV3 changes since ArchV2:
 	// - added deepScan option in allow and deps rules
+	// - added deepScanDepth option in allow
+	// - added allChecks option in allow
+	// - added extends and include sections (composition from several files)
+	// - added module, version, forbidden and allowLocalReplace options in vendors
+	// - added allowModules option in deps rules
+	// - added cannotUseStd section and option in deps rules
+	// - added lowLevelFeatures option in allow and allow{Feature} options in deps rules
+	// - added visibility and allowedImporters options in components
+	// - added owner, description and tags metadata in components
 	ArchV3 struct {
 		FVersion            ref[int]                                    `json:"version"`
+		FExtends            ref[string]                                 `json:"extends"`
+		FInclude            []ref[string]                               `json:"include"`
 		FWorkDir            ref[string]                                 `json:"workdir"`
 		FAllow              ArchV3Allow                                 `json:"allow"`
 		FExclude            []ref[string]                               `json:"exclude"`
 		FExcludeFilesRegExp []ref[string]                               `json:"excludeFiles"`
 		FVendors            map[spec.VendorName]ref[ArchV3Vendor]       `json:"vendors"`
 		FCommonVendors      []ref[string]                               `json:"commonVendors"`
+		FCannotUseStd       []ref[string]                               `json:"cannotUseStd"`
 		FComponents         map[spec.ComponentName]ref[ArchV3Component] `json:"components"`
 		FCommonComponents   []ref[string]                               `json:"commonComponents"`
 		FDependencies       map[spec.ComponentName]ref[ArchV3Rule]      `json:"deps"`
@@ -25,23 +37,42 @@ type (
 	ArchV3Allow struct {
 		FDepOnAnyVendor           ref[bool] `json:"depOnAnyVendor"`
 		FDeepScan                 ref[bool] `json:"deepScan"`
+		FDeepScanDepth            ref[int]  `json:"deepScanDepth"`
+		FAllChecks                ref[bool] `json:"allChecks"`
 		FIgnoreNotFoundComponents ref[bool] `json:"ignoreNotFoundComponents"`
+		FLowLevelFeatures         ref[bool] `json:"lowLevelFeatures"`
 	}
 
 	ArchV3Vendor struct {
-		FImportPaths stringList `json:"in"`
+		FImportPaths       stringList  `json:"in"`
+		FModules           stringList  `json:"module"`
+		FVersion           ref[string] `json:"version"`
+		FForbidden         ref[bool]   `json:"forbidden"`
+		FAllowLocalReplace ref[bool]   `json:"allowLocalReplace"`
 	}
 
 	ArchV3Component struct {
-		FLocalPaths stringList `json:"in"`
+		FLocalPaths       stringList         `json:"in"`
+		FVisibility       ref[string]        `json:"visibility"`
+		FAllowedImporters ref[[]ref[string]] `json:"allowedImporters"`
+		FOwner            ref[string]        `json:"owner"`
+		FDescription      ref[string]        `json:"description"`
+		FTags             []ref[string]      `json:"tags"`
 	}
 
 	ArchV3Rule struct {
-		FMayDependOn    []ref[string] `json:"mayDependOn"`
-		FCanUse         []ref[string] `json:"canUse"`
-		FAnyProjectDeps ref[bool]     `json:"anyProjectDeps"`
-		FAnyVendorDeps  ref[bool]     `json:"anyVendorDeps"`
-		FDeepScan       ref[bool]     `json:"deepScan"`
+		FMayDependOn    []ref[string]      `json:"mayDependOn"`
+		FCanUse         []ref[string]      `json:"canUse"`
+		FAnyProjectDeps ref[bool]          `json:"anyProjectDeps"`
+		FAnyVendorDeps  ref[bool]          `json:"anyVendorDeps"`
+		FDeepScan       ref[bool]          `json:"deepScan"`
+		FAllowModules   ref[[]ref[string]] `json:"allowModules"`
+		FCannotUseStd   []ref[string]      `json:"cannotUseStd"`
+		FAllowCgo       ref[bool]          `json:"allowCgo"`
+		FAllowUnsafe    ref[bool]          `json:"allowUnsafe"`
+		FAllowReflect   ref[bool]          `json:"allowReflect"`
+		FAllowLinkname  ref[bool]          `json:"allowLinkname"`
+		FAllowEmbed     ref[bool]          `json:"allowEmbed"`
 	}
 )
 
@@ -102,6 +133,10 @@ func (a *ArchV3) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV3) CannotUseStd() []common.Referable[string] {
+	return castRefList(a.FCannotUseStd)
+}
+
 func (a *ArchV3) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -139,6 +174,22 @@ func (a ArchV3Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(true)
 }
 
+func (a ArchV3Allow) DeepScanDepth() common.Referable[int] {
+	if a.FDeepScanDepth.defined {
+		return a.FDeepScanDepth.ref
+	}
+
+	return common.NewEmptyReferable(models.DefaultDeepScanDepth)
+}
+
+func (a ArchV3Allow) AllChecks() common.Referable[bool] {
+	if a.FAllChecks.defined {
+		return a.FAllChecks.ref
+	}
+
+	return common.NewEmptyReferable(false)
+}
+
 func (a ArchV3Allow) IgnoreNotFoundComponents() common.Referable[bool] {
 	if a.FIgnoreNotFoundComponents.defined {
 		return a.FIgnoreNotFoundComponents.ref
@@ -148,6 +199,15 @@ func (a ArchV3Allow) IgnoreNotFoundComponents() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
 
+func (a ArchV3Allow) LowLevelFeatures() common.Referable[bool] {
+	if a.FLowLevelFeatures.defined {
+		return a.FLowLevelFeatures.ref
+	}
+
+	// enabled by default
+	return common.NewEmptyReferable(true)
+}
+
 // --
 
 func (a ArchV3Vendor) ImportPaths() []models.Glob {
@@ -160,6 +220,28 @@ func (a ArchV3Vendor) ImportPaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV3Vendor) Modules() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FModules))
+
+	for _, module := range a.FModules {
+		casted = append(casted, models.Glob(module))
+	}
+
+	return casted
+}
+
+func (a ArchV3Vendor) Version() common.Referable[string] {
+	return castRef(a.FVersion)
+}
+
+func (a ArchV3Vendor) Forbidden() common.Referable[bool] {
+	return castRef(a.FForbidden)
+}
+
+func (a ArchV3Vendor) AllowLocalReplace() common.Referable[bool] {
+	return castRef(a.FAllowLocalReplace)
+}
+
 // --
 
 func (a ArchV3Component) RelativePaths() []models.Glob {
@@ -172,6 +254,34 @@ func (a ArchV3Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV3Component) Visibility() common.Referable[string] {
+	if a.FVisibility.defined {
+		return a.FVisibility.ref
+	}
+
+	return common.NewEmptyReferable(models.VisibilityPublic)
+}
+
+func (a ArchV3Component) AllowedImporters() common.Referable[[]common.Referable[string]] {
+	if !a.FAllowedImporters.defined {
+		return common.NewEmptyReferable[[]common.Referable[string]](nil)
+	}
+
+	return common.NewReferable(castRefList(a.FAllowedImporters.ref.Value), a.FAllowedImporters.ref.Reference)
+}
+
+func (a ArchV3Component) Owner() common.Referable[string] {
+	return a.FOwner.ref
+}
+
+func (a ArchV3Component) Description() common.Referable[string] {
+	return a.FDescription.ref
+}
+
+func (a ArchV3Component) Tags() []common.Referable[string] {
+	return castRefList(a.FTags)
+}
+
 // --
 
 func (a ArchV3Rule) MayDependOn() []common.Referable[string] {
@@ -193,3 +303,37 @@ func (a ArchV3Rule) AnyVendorDeps() common.Referable[bool] {
 func (a ArchV3Rule) DeepScan() common.Referable[bool] {
 	return a.FDeepScan.ref
 }
+
+func (a ArchV3Rule) AllowModules() common.Referable[[]common.Referable[string]] {
+	if !a.FAllowModules.defined {
+		return common.NewEmptyReferable[[]common.Referable[string]](nil)
+	}
+
+	return common.NewReferable(castRefList(a.FAllowModules.ref.Value), a.FAllowModules.ref.Reference)
+}
+
+func (a ArchV3Rule) CannotUseStd() []common.Referable[string] {
+	return castRefList(a.FCannotUseStd)
+}
+
+func (a ArchV3Rule) AllowedFeatures() []common.Referable[string] {
+	flags := []struct {
+		feature string
+		allowed ref[bool]
+	}{
+		{feature: models.FeatureCgo, allowed: a.FAllowCgo},
+		{feature: models.FeatureUnsafe, allowed: a.FAllowUnsafe},
+		{feature: models.FeatureReflect, allowed: a.FAllowReflect},
+		{feature: models.FeatureLinkname, allowed: a.FAllowLinkname},
+		{feature: models.FeatureEmbed, allowed: a.FAllowEmbed},
+	}
+
+	features := make([]common.Referable[string], 0)
+	for _, flag := range flags {
+		if flag.allowed.ref.Value {
+			features = append(features, common.NewReferable(flag.feature, flag.allowed.ref.Reference))
+		}
+	}
+
+	return features
+}
@@ -0,0 +1,89 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/toml"
+)
+
+type (
+	// archSource is arch file source code, prepared for yaml decoder.
+	// json is subset of yaml, so it's decoded as is, toml is
+	// transpiled into json before decoding.
+	archSource struct {
+		filePath string
+		format   models.ArchFormat
+		code     []byte
+
+		// positions of transpiled code in original file (only for toml)
+		positions toml.Positions
+	}
+)
+
+func (sp *Decoder) readSource(filePath string, format models.ArchFormat) (archSource, error) {
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return archSource{}, fmt.Errorf("failed to provide source code of archfile: %w", err)
+	}
+
+	if format == models.ArchFormatAuto {
+		format = detectArchFormat(filePath)
+	}
+
+	source := archSource{
+		filePath: filePath,
+		format:   format,
+		code:     sourceCode,
+	}
+
+	switch format {
+	case models.ArchFormatYAML:
+		return source, nil
+	case models.ArchFormatJSON:
+		var document any
+		err = json.Unmarshal(sourceCode, &document)
+		if err != nil {
+			return archSource{}, fmt.Errorf("failed to parse arch file (json): %w", err)
+		}
+
+		return source, nil
+	case models.ArchFormatTOML:
+		source.code, source.positions, err = toml.Transpile(sourceCode)
+		if err != nil {
+			return archSource{}, fmt.Errorf("failed to parse arch file (toml): %w", err)
+		}
+
+		return source, nil
+	}
+
+	return archSource{}, fmt.Errorf("unknown arch file format '%s', available: [%s]",
+		format,
+		strings.Join(models.ArchFormatValues, ", "),
+	)
+}
+
+// translate return position in original arch file
+// for position in prepared source code
+func (s archSource) translate(line int, column int) (int, int) {
+	if s.positions == nil {
+		return line, column
+	}
+
+	return s.positions.Translate(line, column)
+}
+
+func detectArchFormat(filePath string) models.ArchFormat {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return models.ArchFormatJSON
+	case ".toml":
+		return models.ArchFormatTOML
+	}
+
+	return models.ArchFormatYAML
+}
@@ -17,18 +17,23 @@ type stringList []string
 type yamlParentFileCtx struct{}
 
 func (r *ref[T]) UnmarshalYAML(ctx context.Context, node ast.Node, decode func(interface{}) error) error {
-	filePath := ""
-	if ref, ok := ctx.Value(yamlParentFileCtx{}).(string); ok {
-		filePath = ref
+	source := archSource{}
+	if ref, ok := ctx.Value(yamlParentFileCtx{}).(archSource); ok {
+		source = ref
 	}
 
-	r.defined = true
-	r.ref.Reference = common.NewReferenceSingleLine(
-		filePath,
+	line, column := source.translate(
 		node.GetToken().Position.Line,
 		node.GetToken().Position.Column,
 	)
 
+	r.defined = true
+	r.ref.Reference = common.NewReferenceSingleLine(
+		source.filePath,
+		line,
+		column,
+	)
+
 	return decode(&r.ref.Value)
 }
 
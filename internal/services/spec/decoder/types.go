@@ -6,7 +6,7 @@ import (
 
 type (
 	yamlSourceCodeReferenceResolver interface {
-		Resolve(filePath string, yamlPath string) common.Reference
+		ResolveSource(filePath string, sourceCode []byte, yamlPath string) common.Reference
 	}
 
 	jsonSchemaProvider interface {
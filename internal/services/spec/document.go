@@ -53,6 +53,9 @@ type (
 		// CommonVendors is list of Vendors that can be imported to any project package
 		CommonVendors() []common.Referable[string]
 
+		// CannotUseStd is list of std packages (glob) that can`t be imported to any project package
+		CannotUseStd() []common.Referable[string]
+
 		// Components (map)
 		Components() Components
 
@@ -72,9 +75,22 @@ type (
 		// this is default behavior since v3+ configs
 		DeepScan() common.Referable[bool]
 
+		// DeepScanDepth limits how many nested function calls (factories, closures)
+		// deepscan will follow, when injected value is typed by interface
+		DeepScanDepth() common.Referable[int]
+
+		// AllChecks will run all checkers, even when previous
+		// checker already found some warnings (disabled by default)
+		AllChecks() common.Referable[bool]
+
 		// IgnoreNotFoundComponents skips components that are not found by their glob
 		// disabled by default
 		IgnoreNotFoundComponents() common.Referable[bool]
+
+		// LowLevelFeatures allow cgo, unsafe, reflect, linkname and embed in
+		// all components (enabled by default), when disabled, features should
+		// be allowed explicitly for component in deps rules
+		LowLevelFeatures() common.Referable[bool]
 	}
 
 	Vendor interface {
@@ -83,6 +99,25 @@ type (
 		// 	- golang.org/x/mod/modfile
 		// 	- example.com/*/libs/**
 		ImportPaths() []models.Glob
+
+		// Modules is list of go modules (from go.mod), all packages of
+		// this modules is part of vendor, support glob masking
+		// example:
+		// 	- github.com/pkg/errors
+		// 	- gopkg.in/yaml.v3
+		Modules() []models.Glob
+
+		// Version is semver constraint for required version of vendor Modules
+		// example: ">=1.4 <2"
+		Version() common.Referable[string]
+
+		// Forbidden vendor can`t be imported into any project package (even
+		// with depOnAnyVendor/anyVendorDeps) and required in go.mod
+		Forbidden() common.Referable[bool]
+
+		// AllowLocalReplace allows to replace vendor Modules with
+		// local directories (forks) in go.mod (disabled by default)
+		AllowLocalReplace() common.Referable[bool]
 	}
 
 	Component interface {
@@ -92,6 +127,25 @@ type (
 		// 	- /
 		// 	- tests/**
 		RelativePaths() []models.Glob
+
+		// Visibility is "public" (default) or "private", private component
+		// can be imported only from parent directory of its packages
+		Visibility() common.Referable[string]
+
+		// AllowedImporters is list of Component names, that can import described
+		// component. Value is nil, when option is not defined, in this case
+		// component can be imported by any component (with mayDependOn rule)
+		AllowedImporters() common.Referable[[]common.Referable[string]]
+
+		// Owner is team (or person), responsible for component, when empty
+		// owner will be found in project CODEOWNERS file (example: @org/team-payments)
+		Owner() common.Referable[string]
+
+		// Description is human-readable component summary
+		Description() common.Referable[string]
+
+		// Tags is free form component labels
+		Tags() []common.Referable[string]
 	}
 
 	DependencyRule interface {
@@ -109,5 +163,16 @@ type (
 
 		// DeepScan overrides deepScan global option
 		DeepScan() common.Referable[bool]
+
+		// AllowModules is list of go.mod modules (glob), that can be imported to
+		// described component. Value is nil, when option is not defined, in this case
+		// vendor imports is not restricted by modules
+		AllowModules() common.Referable[[]common.Referable[string]]
+
+		// CannotUseStd is list of std packages (glob), that can`t be imported to described component
+		CannotUseStd() []common.Referable[string]
+
+		// AllowedFeatures is list of low level features (models.Feature*), allowed in described component
+		AllowedFeatures() []common.Referable[string]
 	}
 )
@@ -0,0 +1,254 @@
+package migrator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-yaml/ast"
+	"github.com/fe3dback/go-yaml/parser"
+)
+
+const (
+	defaultIndent = "  "
+	versionKey    = "version"
+	allowKey      = "allow"
+	workdirKey    = "workdir"
+	deepScanKey   = "deepScan"
+)
+
+type (
+	// Migrator will rewrite arch file into latest version.
+	// All changes is applied as text patches in positions, found
+	// by yaml AST, so comments, formatting and keys order is kept
+	Migrator struct{}
+
+	document struct {
+		lines    []string
+		rootNode ast.Node
+		root     []*ast.MappingValueNode
+		inserts  map[int][]string // line index -> lines inserted after it
+	}
+)
+
+func NewMigrator() *Migrator {
+	return &Migrator{}
+}
+
+// Migrate return migrated source code and list of applied changes
+func (m *Migrator) Migrate(sourceCode []byte, toVersion int) (models.SpecMigration, error) {
+	if toVersion != models.SupportedVersionMax {
+		return models.SpecMigration{}, fmt.Errorf("migration is supported only to latest version %d", models.SupportedVersionMax)
+	}
+
+	doc, err := parseDocument(sourceCode)
+	if err != nil {
+		return models.SpecMigration{}, err
+	}
+
+	versionNode := doc.find(doc.root, versionKey)
+	if versionNode == nil {
+		return models.SpecMigration{}, fmt.Errorf("not found 'version' in arch file")
+	}
+
+	fromVersion, err := strconv.Atoi(versionNode.Value.GetToken().Value)
+	if err != nil {
+		return models.SpecMigration{}, fmt.Errorf("invalid 'version' in arch file: %w", err)
+	}
+
+	migration := models.SpecMigration{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		SourceCode:  sourceCode,
+		Changes:     []string{},
+	}
+
+	if fromVersion < models.SupportedVersionMin || fromVersion > toVersion {
+		return models.SpecMigration{}, fmt.Errorf("can`t migrate arch file from version %d to %d", fromVersion, toVersion)
+	}
+
+	if fromVersion == toVersion {
+		return migration, nil
+	}
+
+	// v1 -> v2: nothing to change, v2 is superset of v1
+
+	// v2 -> v3:
+	// deepScan is on by default since v3, but old versions
+	// is checked without it
+	migration.Changes = append(migration.Changes, doc.setOption(deepScanKey, "false",
+		fmt.Sprintf("allow.%s = false (on by default since v3, but not used in v%d)", deepScanKey, fromVersion),
+	))
+
+	migration.Changes = append(migration.Changes, doc.setVersion(versionNode, toVersion, fromVersion))
+	migration.SourceCode = doc.bytes()
+	return migration, nil
+}
+
+func parseDocument(sourceCode []byte) (*document, error) {
+	file, err := parser.ParseBytes(sourceCode, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse arch file (yaml): %w", err)
+	}
+
+	if len(file.Docs) == 0 || file.Docs[0].Body == nil {
+		return nil, fmt.Errorf("arch file is empty")
+	}
+
+	root := mappingValues(file.Docs[0].Body)
+	if root == nil {
+		return nil, fmt.Errorf("arch file root should be mapping")
+	}
+
+	return &document{
+		lines:    strings.Split(string(sourceCode), "\n"),
+		rootNode: file.Docs[0].Body,
+		root:     root,
+		inserts:  map[int][]string{},
+	}, nil
+}
+
+func (d *document) setVersion(node *ast.MappingValueNode, toVersion, fromVersion int) string {
+	token := node.Value.GetToken()
+	line := d.lines[token.Position.Line-1]
+	column := token.Position.Column - 1
+
+	d.lines[token.Position.Line-1] = line[:column] + strconv.Itoa(toVersion) + line[column+len(token.Value):]
+
+	return fmt.Sprintf("version = %d (from %d)", toVersion, fromVersion)
+}
+
+// setOption will add option into 'allow' section,
+// section will be created, when not exist
+func (d *document) setOption(key string, value string, change string) string {
+	allow := d.find(d.root, allowKey)
+
+	if allow == nil {
+		if mapping, ok := d.rootNode.(*ast.MappingNode); ok && mapping.IsFlowStyle {
+			// {version: 1, components: {...}}
+			d.appendToFlowMapping(mapping, allowKey+": { "+key+": "+value+" }")
+			return change
+		}
+
+		// new section is placed right after version/workdir
+		anchor := d.find(d.root, versionKey)
+		if workdir := d.find(d.root, workdirKey); workdir != nil {
+			anchor = workdir
+		}
+
+		d.insertAfter(lastLine(anchor), true,
+			allowKey+":",
+			d.indent()+key+": "+value,
+		)
+
+		return change
+	}
+
+	if mapping, ok := allow.Value.(*ast.MappingNode); ok && mapping.IsFlowStyle {
+		// allow: { depOnAnyVendor: true }
+		d.appendToFlowMapping(mapping, key+": "+value)
+		return change
+	}
+
+	values := mappingValues(allow.Value)
+	if len(values) == 0 {
+		// allow: (empty section)
+		d.insertAfter(allow.Key.GetToken().Position.Line-1, false, d.indent()+key+": "+value)
+		return change
+	}
+
+	first := values[0].Key.GetToken().Position
+	indent := strings.Repeat(" ", first.Column-1)
+	d.insertAfter(lastLine(values[len(values)-1]), false, indent+key+": "+value)
+
+	return change
+}
+
+// appendToFlowMapping will add new key right before closing
+// brace of mapping, like: `{ a: 1 }` -> `{ a: 1, b: 2 }`
+func (d *document) appendToFlowMapping(mapping *ast.MappingNode, keyValue string) {
+	end := mapping.End.Position
+	line := d.lines[end.Line-1]
+	column := end.Column - 1
+
+	separator := ", "
+	if len(mapping.Values) == 0 {
+		separator = ""
+	}
+
+	d.lines[end.Line-1] = strings.TrimRight(line[:column], " ") + separator + keyValue + " " + line[column:]
+}
+
+func (d *document) find(values []*ast.MappingValueNode, key string) *ast.MappingValueNode {
+	for _, value := range values {
+		if value.Key.GetToken().Value == key {
+			return value
+		}
+	}
+
+	return nil
+}
+
+// indent is detected from first nested key in document
+func (d *document) indent() string {
+	for _, value := range d.root {
+		nested := mappingValues(value.Value)
+		if len(nested) == 0 {
+			continue
+		}
+
+		if mapping, ok := value.Value.(*ast.MappingNode); ok && mapping.IsFlowStyle {
+			continue
+		}
+
+		column := nested[0].Key.GetToken().Position.Column
+		if column > 1 {
+			return strings.Repeat(" ", column-1)
+		}
+	}
+
+	return defaultIndent
+}
+
+// insertAfter will insert new lines after line index. With section=true
+// new lines is separated by empty line, when document already use
+// empty lines between sections
+func (d *document) insertAfter(ind int, section bool, lines ...string) {
+	if section && ind+1 < len(d.lines) && strings.TrimSpace(d.lines[ind+1]) == "" {
+		lines = append([]string{""}, lines...)
+	}
+
+	d.inserts[ind] = append(d.inserts[ind], lines...)
+}
+
+func (d *document) bytes() []byte {
+	result := make([]string, 0, len(d.lines))
+	for ind, line := range d.lines {
+		result = append(result, line)
+		result = append(result, d.inserts[ind]...)
+	}
+
+	return []byte(strings.Join(result, "\n"))
+}
+
+// lastLine return index of last line, used by scalar mapping value
+func lastLine(node *ast.MappingValueNode) int {
+	line := node.Value.GetToken().Position.Line
+	if keyLine := node.Key.GetToken().Position.Line; keyLine > line {
+		line = keyLine
+	}
+
+	return line - 1
+}
+
+func mappingValues(node ast.Node) []*ast.MappingValueNode {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		return n.Values
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}
+	}
+
+	return nil
+}
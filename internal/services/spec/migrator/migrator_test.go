@@ -0,0 +1,85 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator_Migrate(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    string
+		changes int
+	}{
+		{
+			name:    "v1 without allow",
+			source:  "version: 1\n\n# comment\ncomponents:\n    a: { in: a }\n",
+			want:    "version: 3\n\nallow:\n    deepScan: false\n\n# comment\ncomponents:\n    a: { in: a }\n",
+			changes: 2,
+		},
+		{
+			name:    "v2 with workdir",
+			source:  "version: 2\nworkdir: internal\ncomponents:\n  a: { in: a }\n",
+			want:    "version: 3\nworkdir: internal\nallow:\n  deepScan: false\ncomponents:\n  a: { in: a }\n",
+			changes: 2,
+		},
+		{
+			name:    "block allow",
+			source:  "version: 2 # old\nallow:\n  depOnAnyVendor: true # keep\n\ncomponents: {}\n",
+			want:    "version: 3 # old\nallow:\n  depOnAnyVendor: true # keep\n  deepScan: false\n\ncomponents: {}\n",
+			changes: 2,
+		},
+		{
+			name:    "flow allow",
+			source:  "version: 1\nallow: { depOnAnyVendor: true }\n",
+			want:    "version: 3\nallow: { depOnAnyVendor: true, deepScan: false }\n",
+			changes: 2,
+		},
+		{
+			name:    "flow document",
+			source:  "{version: 1, components: {a: {in: a}}}\n",
+			want:    "{version: 3, components: {a: {in: a}}, allow: { deepScan: false } }\n",
+			changes: 2,
+		},
+		{
+			name:    "latest version",
+			source:  "version: 3\n",
+			want:    "version: 3\n",
+			changes: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMigrator().Migrate([]byte(tt.source), 3)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, string(got.SourceCode))
+			assert.Len(t, got.Changes, tt.changes)
+			assert.Equal(t, 3, got.ToVersion)
+		})
+	}
+}
+
+func TestMigrator_MigrateErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		toVersion int
+	}{
+		{name: "not latest version", source: "version: 1\n", toVersion: 2},
+		{name: "without version", source: "components: {}\n", toVersion: 3},
+		{name: "unknown version", source: "version: 4\n", toVersion: 3},
+		{name: "invalid yaml", source: "version: [1\n", toVersion: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMigrator().Migrate([]byte(tt.source), tt.toVersion)
+			assert.Error(t, err)
+		})
+	}
+}
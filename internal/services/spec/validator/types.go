@@ -13,4 +13,8 @@ type (
 	pathResolver interface {
 		Resolve(absPath string) (resolvePaths []string, err error)
 	}
+
+	stdPackagesProvider interface {
+		StdPackages() []string
+	}
 )
@@ -6,14 +6,17 @@ import (
 )
 
 type Validator struct {
-	pathResolver pathResolver
+	pathResolver        pathResolver
+	stdPackagesProvider stdPackagesProvider
 }
 
 func NewValidator(
 	pathResolver pathResolver,
+	stdPackagesProvider stdPackagesProvider,
 ) *Validator {
 	return &Validator{
-		pathResolver: pathResolver,
+		pathResolver:        pathResolver,
+		stdPackagesProvider: stdPackagesProvider,
 	}
 }
 
@@ -29,6 +32,7 @@ func (v *Validator) Validate(doc spec.Document, projectDir string) []arch.Notice
 		newValidatorDepsComponents(utils),
 		newValidatorDepsVendors(utils),
 		newValidatorExcludeFiles(),
+		newValidatorStd(v.stdPackagesProvider),
 		newValidatorVendors(utils),
 		newValidatorVersion(),
 		newValidatorWorkDir(utils),
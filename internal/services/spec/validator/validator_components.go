@@ -44,6 +44,15 @@ func (v *validatorComponents) Validate(doc spec.Document) []arch.Notice {
 				})
 			}
 		}
+
+		for _, importerName := range component.Value.AllowedImporters().Value {
+			if err := v.utils.assertKnownComponent(importerName.Value); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    importerName.Reference,
+				})
+			}
+		}
 	}
 
 	return notices
@@ -44,7 +44,18 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 			})
 		}
 
+		if rule.Value.AllowModules().Value != nil && rule.Value.AnyVendorDeps().Value {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("'AnyVendorDeps=true' used with 'allowModules' list, vendor imports is limited by modules"),
+				Ref:    rule.Value.AnyVendorDeps().Reference,
+			})
+		}
+
 		if len(rule.Value.MayDependOn()) == 0 && len(rule.Value.CanUse()) == 0 {
+			if rule.Value.AllowModules().Value != nil {
+				continue
+			}
+
 			if rule.Value.AnyProjectDeps().Value {
 				continue
 			}
@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorStd struct {
+	stdPackagesProvider stdPackagesProvider
+}
+
+func newValidatorStd(
+	stdPackagesProvider stdPackagesProvider,
+) *validatorStd {
+	return &validatorStd{
+		stdPackagesProvider: stdPackagesProvider,
+	}
+}
+
+func (v *validatorStd) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	stdGlobs := make([]common.Referable[string], 0)
+	stdGlobs = append(stdGlobs, doc.CannotUseStd()...)
+
+	componentNames := make([]string, 0, len(doc.Dependencies()))
+	for name := range doc.Dependencies() {
+		componentNames = append(componentNames, name)
+	}
+
+	sort.Strings(componentNames)
+
+	for _, name := range componentNames {
+		stdGlobs = append(stdGlobs, doc.Dependencies()[name].Value.CannotUseStd()...)
+	}
+
+	if len(stdGlobs) == 0 {
+		return notices
+	}
+
+	stdPackages := v.stdPackagesProvider.StdPackages()
+	for _, stdGlob := range stdGlobs {
+		if err := v.assertStdGlob(models.Glob(stdGlob.Value), stdPackages); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    stdGlob.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorStd) assertStdGlob(stdGlob models.Glob, stdPackages []string) error {
+	for _, stdPackage := range stdPackages {
+		matched, err := stdGlob.Match(stdPackage)
+		if err != nil {
+			return fmt.Errorf("invalid std glob '%s': %w", stdGlob, err)
+		}
+
+		if matched {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("not found std packages for '%s'", stdGlob)
+}
@@ -1,7 +1,11 @@
 package validator
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/version"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -17,6 +21,73 @@ func newValidatorVendors(
 	}
 }
 
-func (v *validatorVendors) Validate(_ spec.Document) []arch.Notice {
-	return make([]arch.Notice, 0)
+func (v *validatorVendors) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	names := make([]string, 0, len(doc.Vendors()))
+	for name := range doc.Vendors() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		vendor := doc.Vendors()[name].Value
+		constraint := vendor.Version()
+
+		if constraint.Value != "" {
+			if len(vendor.Modules()) == 0 {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("vendor '%s' version constraint can be used only with 'module'", name),
+					Ref:    constraint.Reference,
+				})
+			}
+
+			if _, err := version.ParseConstraint(constraint.Value); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("vendor '%s' has invalid version constraint '%s': %w", name, constraint.Value, err),
+					Ref:    constraint.Reference,
+				})
+			}
+		}
+	}
+
+	// forbidden vendors can`t be allowed anywhere
+	for _, vendorName := range doc.CommonVendors() {
+		if v.isForbidden(doc, vendorName.Value) {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("vendor '%s' is forbidden, and can`t be used in common vendors", vendorName.Value),
+				Ref:    vendorName.Reference,
+			})
+		}
+	}
+
+	componentNames := make([]string, 0, len(doc.Dependencies()))
+	for name := range doc.Dependencies() {
+		componentNames = append(componentNames, name)
+	}
+
+	sort.Strings(componentNames)
+
+	for _, name := range componentNames {
+		for _, vendorName := range doc.Dependencies()[name].Value.CanUse() {
+			if v.isForbidden(doc, vendorName.Value) {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("vendor '%s' is forbidden, and can`t be used in '%s' deps", vendorName.Value, name),
+					Ref:    vendorName.Reference,
+				})
+			}
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorVendors) isForbidden(doc spec.Document, name string) bool {
+	vendor, exist := doc.Vendors()[name]
+	if !exist {
+		return false
+	}
+
+	return vendor.Value.Forbidden().Value
 }
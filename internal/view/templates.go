@@ -10,6 +10,9 @@ import (
 //go:embed view_check.gohtml
 var viewCheck []byte
 
+//go:embed view_deepscan_inventory.gohtml
+var viewDeepscanInventory []byte
+
 //go:embed view_error.gohtml
 var viewError []byte
 
@@ -19,6 +22,18 @@ var viewGraph []byte
 //go:embed view_mapping.gohtml
 var viewMapping []byte
 
+//go:embed view_metrics.gohtml
+var viewMetrics []byte
+
+//go:embed view_migrate.gohtml
+var viewMigrate []byte
+
+//go:embed view_modules.gohtml
+var viewModules []byte
+
+//go:embed view_report.gohtml
+var viewReport []byte
+
 //go:embed view_schema.gohtml
 var viewSchema []byte
 
@@ -29,15 +44,26 @@ var viewSelfInspect []byte
 var viewVersion []byte
 
 var Templates = map[string]string{
-	tpl(models.CmdCheckOut{}):       string(viewCheck),
-	tpl(models.CmdErrorOut{}):       string(viewError),
-	tpl(models.CmdGraphOut{}):       string(viewGraph),
-	tpl(models.CmdMappingOut{}):     string(viewMapping),
-	tpl(models.CmdSchemaOut{}):      string(viewSchema),
-	tpl(models.CmdSelfInspectOut{}): string(viewSelfInspect),
-	tpl(models.CmdVersionOut{}):     string(viewVersion),
+	tpl(models.CmdCheckOut{}):             string(viewCheck),
+	tpl(models.CmdDeepscanInventoryOut{}): string(viewDeepscanInventory),
+	tpl(models.CmdErrorOut{}):             string(viewError),
+	tpl(models.CmdGraphOut{}):             string(viewGraph),
+	tpl(models.CmdMappingOut{}):           string(viewMapping),
+	tpl(models.CmdMetricsOut{}):           string(viewMetrics),
+	tpl(models.CmdMigrateOut{}):           string(viewMigrate),
+	tpl(models.CmdModulesOut{}):           string(viewModules),
+	tpl(models.CmdReportOut{}):            string(viewReport),
+	tpl(models.CmdSchemaOut{}):            string(viewSchema),
+	tpl(models.CmdSelfInspectOut{}):       string(viewSelfInspect),
+	tpl(models.CmdVersionOut{}):           string(viewVersion),
 }
 
+// ReportHTML is html template for index page of static report
+// site, it is executed with models.ReportPage data
+//
+//go:embed report_html.gohtml
+var ReportHTML string
+
 func tpl(model interface{}) string {
 	return fmt.Sprintf("%T", model)
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmdtest"
@@ -13,6 +15,9 @@ import (
 
 const binaryName = "go-arch-lint"
 
+// checker execution time is different on every run
+var jsonDurationRegexp = regexp.MustCompile(`"Duration": \d+`)
+
 var update = flag.Bool("update", false, "update test files with results")
 
 func TestCLI(t *testing.T) {
@@ -35,6 +40,75 @@ func TestCLI(t *testing.T) {
 		return nil
 	}
 
-	ts.Commands[binaryName] = cmdtest.InProcessProgram(binaryName, run)
+	ts.Commands[binaryName] = scrubDurations(cmdtest.InProcessProgram(binaryName, run))
+	ts.Commands["grep"] = grepCmd
+	ts.Commands["contains"] = containsCmd
+	ts.Commands["rm"] = rmCmd
 	ts.Run(t, *update)
 }
+
+// scrubDurations replace checkers execution time in json output
+// with placeholder, to make test output stable
+func scrubDurations(cmd cmdtest.CommandFunc) cmdtest.CommandFunc {
+	return func(args []string, inputFile string) ([]byte, error) {
+		out, err := cmd(args, inputFile)
+		return jsonDurationRegexp.ReplaceAll(out, []byte(`"Duration": "<duration>"`)), err
+	}
+}
+
+// grepCmd output trimmed lines of file, that contain substring
+// usage: grep SUBSTRING FILE
+func grepCmd(args []string, _ string) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("need 2 arguments, got %d", len(args))
+	}
+
+	content, err := os.ReadFile(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.Contains(line, args[0]) {
+			out.WriteString(strings.TrimSpace(line) + "\n")
+		}
+	}
+
+	return []byte(out.String()), nil
+}
+
+// containsCmd check that file contain all substrings (useful for
+// generated files with long lines, like svg)
+// usage: contains FILE SUBSTRING...
+func containsCmd(args []string, _ string) ([]byte, error) {
+	if len(args) < 2 {
+		return nil, fmt.Errorf("need at least 2 arguments, got %d", len(args))
+	}
+
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+	for _, substring := range args[1:] {
+		if !strings.Contains(string(content), substring) {
+			return nil, fmt.Errorf("'%s' not found in %s", substring, filepath.Base(args[0]))
+		}
+
+		out.WriteString("found: " + substring + "\n")
+	}
+
+	return []byte(out.String()), nil
+}
+
+// rmCmd remove files, created by test
+// usage: rm PATH
+func rmCmd(args []string, _ string) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("need 1 argument, got %d", len(args))
+	}
+
+	return nil, os.RemoveAll(args[0])
+}
@@ -0,0 +1,294 @@
+package archlint
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker"
+	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
+)
+
+// Analyzer check package imports by project arch file, it can be used
+// in multichecker, `go vet -vettool` or gopls. Project directory and arch
+// file is detected from package path (nearest go.mod), or can be set with
+// -project-path and -arch-file flags.
+var Analyzer = NewAnalyzer(Options{})
+
+type (
+	// componentFact is component of package, exported
+	// for using in diagnostics of importing packages
+	componentFact struct {
+		Name string
+	}
+
+	analyzerRunner struct {
+		opts Options
+
+		mux   sync.Mutex
+		specs map[string]analyzerSpec // project directory -> spec
+	}
+
+	analyzerSpec struct {
+		spec    arch.Spec
+		scanner *scanner.Scanner
+		found   bool
+		err     error
+	}
+)
+
+func (*componentFact) AFact() {}
+
+func (f *componentFact) String() string {
+	return fmt.Sprintf("component(%s)", f.Name)
+}
+
+// NewAnalyzer return analyzer with custom options, only ProjectPath,
+// ArchFile and ArchFormat is used, all checks is always on
+func NewAnalyzer(opts Options) *analysis.Analyzer {
+	runner := &analyzerRunner{
+		opts:  opts,
+		specs: make(map[string]analyzerSpec),
+	}
+
+	analyzer := &analysis.Analyzer{
+		Name:      "archlint",
+		Doc:       "check that package imports match to project arch file (go-arch-lint)",
+		URL:       "https://github.com/fe3dback/go-arch-lint",
+		Run:       runner.run,
+		FactTypes: []analysis.Fact{new(componentFact)},
+	}
+
+	analyzer.Flags.StringVar(&runner.opts.ProjectPath, "project-path", opts.ProjectPath, "absolute path to project directory (default: nearest go.mod)")
+	analyzer.Flags.StringVar(&runner.opts.ArchFile, "arch-file", opts.ArchFile, "arch file path")
+	analyzer.Flags.StringVar(&runner.opts.ArchFormat, "arch-format", opts.ArchFormat, "format of arch file (default: detected by file extension)")
+
+	return analyzer
+}
+
+func (r *analyzerRunner) run(pass *analysis.Pass) (any, error) {
+	if len(pass.Files) == 0 {
+		return nil, nil
+	}
+
+	packagePath := filepath.Dir(pass.Fset.Position(pass.Files[0].Package).Filename)
+
+	projectPath := r.opts.ProjectPath
+	if projectPath == "" {
+		projectPath = NearestModuleDirectory(packagePath)
+	}
+
+	if projectPath == "" {
+		return nil, nil
+	}
+
+	loaded := r.loadSpec(projectPath)
+	if loaded.err != nil {
+		return nil, loaded.err
+	}
+
+	spec := loaded.spec
+	if !loaded.found || !inSpecScope(spec, packagePath) {
+		// not a project package (std, vendors, etc..)
+		return nil, nil
+	}
+
+	componentName := holder.NewHolder().HoldPackage(packagePath, spec.Components)
+	if componentName == nil {
+		// not attached packages is reported by `go-arch-lint check`
+		return nil, nil
+	}
+
+	pass.ExportPackageFact(&componentFact{Name: *componentName})
+
+	var component arch.Component
+	for _, specComponent := range spec.Components {
+		if specComponent.Name.Value == *componentName {
+			component = specComponent
+		}
+	}
+
+	// components of imported project packages, same as in project
+	// imports checker, but resolved from facts of imported packages
+	importedComponents := make(map[string]string)
+	for _, imported := range pass.Pkg.Imports() {
+		var fact componentFact
+		if pass.ImportPackageFact(imported, &fact) {
+			importedComponents[imported.Path()] = fact.Name
+		}
+	}
+
+	component = checker.HoldDeniedImports(component, importedComponents)
+
+	for _, file := range pass.Files {
+		fileName := pass.Fset.Position(file.Package).Filename
+		if excludedFile(spec, fileName) {
+			continue
+		}
+
+		for _, importSpec := range file.Imports {
+			err := r.checkImport(pass, loaded, component, importedComponents, importSpec)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *analyzerRunner) checkImport(
+	pass *analysis.Pass,
+	loaded analyzerSpec,
+	component arch.Component,
+	importedComponents map[string]string,
+	importSpec *ast.ImportSpec,
+) error {
+	importPath, err := strconv.Unquote(importSpec.Path.Value)
+	if err != nil {
+		return fmt.Errorf("invalid import path %s: %w", importSpec.Path.Value, err)
+	}
+
+	resolvedImport := models.ResolvedImport{
+		Name:       importPath,
+		ImportType: loaded.scanner.ImportType(loaded.spec.ModuleName.Value, importPath),
+		Reference:  astUtil.PositionFromToken(pass.Fset.Position(importSpec.Pos())),
+	}
+
+	allowed, err := checker.CheckImport(loaded.spec, component, resolvedImport)
+	if err != nil {
+		return fmt.Errorf("failed check import '%s': %w", importPath, err)
+	}
+
+	if allowed {
+		return nil
+	}
+
+	// rejected by allowedImporters of imported component
+	reason := ""
+	if rejectReason := checker.ImportRejectReason(component, resolvedImport); rejectReason != "" {
+		reason = fmt.Sprintf(": %s", rejectReason)
+	}
+
+	if importedComponent, ok := importedComponents[importPath]; ok {
+		pass.Reportf(importSpec.Pos(), "Component %s shouldn't depend on %s (component %s)%s", component.Name.Value, importPath, importedComponent, reason)
+		return nil
+	}
+
+	pass.Reportf(importSpec.Pos(), "Component %s shouldn't depend on %s%s", component.Name.Value, importPath, reason)
+	return nil
+}
+
+// loadSpec assemble spec once for every project directory,
+// arch file not exist in not linted projects (std, vendors)
+func (r *analyzerRunner) loadSpec(projectPath string) analyzerSpec {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if loaded, exist := r.specs[projectPath]; exist {
+		return loaded
+	}
+
+	loaded := r.assembleSpec(projectPath)
+	r.specs[projectPath] = loaded
+
+	return loaded
+}
+
+func (r *analyzerRunner) assembleSpec(projectPath string) analyzerSpec {
+	opts, err := r.opts.withDefaults()
+	if err != nil {
+		return analyzerSpec{err: err}
+	}
+
+	if !archFileExist(projectPath, opts.ArchFile) {
+		return analyzerSpec{}
+	}
+
+	lnt := NewLinter(opts.ArchFormat, false)
+	projectInfo, err := lnt.ProjectInfoAssembler().ProjectInfo(projectPath, opts.ArchFile)
+	if err != nil {
+		return analyzerSpec{err: fmt.Errorf("failed to assemble project info: %w", err)}
+	}
+
+	spec, err := lnt.SpecAssembler().Assemble(projectInfo)
+	if err != nil {
+		return analyzerSpec{err: fmt.Errorf("failed to assemble spec: %w", err)}
+	}
+
+	if len(spec.Integrity.DocumentNotices) > 0 {
+		notice := spec.Integrity.DocumentNotices[0]
+		return analyzerSpec{err: fmt.Errorf("invalid arch file: %s (%s)", notice.Notice, notice.Ref)}
+	}
+
+	return analyzerSpec{spec: spec, scanner: lnt.ProjectScanner(), found: true}
+}
+
+func archFileExist(projectPath string, archFile string) bool {
+	archFiles := []string{archFile}
+	if archFile == models.DefaultArchFileName {
+		archFiles = models.DefaultArchFileNames
+	}
+
+	for _, fileName := range archFiles {
+		if !filepath.IsAbs(fileName) {
+			fileName = filepath.Join(projectPath, fileName)
+		}
+
+		if _, err := os.Stat(fileName); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NearestModuleDirectory return closest directory with go.mod file, starting
+// from packagePath and up to file system root. Empty string is returned,
+// when go.mod is not found
+func NearestModuleDirectory(packagePath string) string {
+	for directory := packagePath; ; directory = filepath.Dir(directory) {
+		if _, err := os.Stat(filepath.Join(directory, models.DefaultGoModFileName)); err == nil {
+			return directory
+		}
+
+		if filepath.Dir(directory) == directory {
+			return ""
+		}
+	}
+}
+
+func inSpecScope(spec arch.Spec, packagePath string) bool {
+	scanDirectory := filepath.Join(spec.RootDirectory.Value, spec.WorkingDirectory.Value)
+	if packagePath != scanDirectory && !strings.HasPrefix(packagePath, scanDirectory+string(filepath.Separator)) {
+		return false
+	}
+
+	for _, excluded := range spec.Exclude {
+		if strings.HasPrefix(packagePath, excluded.Value.AbsPath) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func excludedFile(spec arch.Spec, fileName string) bool {
+	for _, matcher := range spec.ExcludeFilesMatcher {
+		if matcher.Value.MatchString(fileName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+// Package archlint is public API of go-arch-lint, it allows
+// to run linter as library, for example in project tests:
+//
+//	func TestArchitecture(t *testing.T) {
+//		result, err := archlint.Check(context.Background(), archlint.Options{
+//			ProjectPath: "../..",
+//		})
+//		require.NoError(t, err)
+//		assert.Empty(t, result.Messages())
+//	}
+package archlint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/check"
+	"github.com/fe3dback/go-arch-lint/internal/operations/mapping"
+)
+
+const defaultMaxWarnings = 512
+
+// LoadSpec will parse and validate arch file. Problems in arch file is
+// returned as notices, error is returned only when arch file can`t be read
+func LoadSpec(opts Options) (Spec, []Notice, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Spec{}, nil, err
+	}
+
+	lnt := NewLinter(opts.ArchFormat, false)
+	projectInfo, err := lnt.ProjectInfoAssembler().ProjectInfo(opts.ProjectPath, opts.ArchFile)
+	if err != nil {
+		return Spec{}, nil, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	spec, err := lnt.SpecAssembler().Assemble(projectInfo)
+	if err != nil {
+		return Spec{}, nil, fmt.Errorf("failed to assemble spec: %w", err)
+	}
+
+	return convertSpec(spec), convertNotices(spec.Integrity.DocumentNotices), nil
+}
+
+// Check will lint project with arch file. Found warnings is
+// returned in result (see Result.HasWarnings), error is returned
+// only when linter can`t be executed
+func Check(ctx context.Context, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+
+	lnt := NewLinter(opts.ArchFormat, false)
+	operation := check.NewOperation(
+		lnt.ProjectInfoAssembler(),
+		lnt.SpecAssembler(),
+		lnt.SpecChecker(),
+		lnt.ReferenceRender(),
+		false,
+	)
+
+	out, err := operation.Behave(ctx, models.CmdCheckIn{
+		ProjectPath: opts.ProjectPath,
+		ArchFile:    opts.ArchFile,
+		MaxWarnings: opts.MaxWarnings,
+		Checks:      opts.Checks,
+		AllChecks:   opts.AllChecks,
+		Owner:       opts.Owner,
+	})
+	if err != nil && !errors.Is(err, models.UserSpaceError{}) {
+		return Result{}, err
+	}
+
+	return convertCheckResult(out), nil
+}
+
+// Mapping return component of every project file
+func Mapping(ctx context.Context, opts Options) (MappingResult, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return MappingResult{}, err
+	}
+
+	lnt := NewLinter(opts.ArchFormat, false)
+	operation := mapping.NewOperation(
+		lnt.SpecAssembler(),
+		lnt.ProjectFilesResolver(),
+		lnt.ProjectInfoAssembler(),
+	)
+
+	out, err := operation.Behave(ctx, models.CmdMappingIn{
+		ProjectPath: opts.ProjectPath,
+		ArchFile:    opts.ArchFile,
+		Scheme:      models.MappingSchemeList,
+	})
+	if err != nil {
+		return MappingResult{}, err
+	}
+
+	return convertMappingResult(out), nil
+}
+
+func (opts Options) withDefaults() (Options, error) {
+	if opts.ProjectPath == "" {
+		opts.ProjectPath = models.DefaultProjectPath
+	}
+
+	if opts.ArchFile == "" {
+		opts.ArchFile = models.DefaultArchFileName
+	}
+
+	if opts.MaxWarnings == 0 {
+		opts.MaxWarnings = defaultMaxWarnings
+	}
+
+	if opts.MaxWarnings < 0 {
+		return Options{}, fmt.Errorf("max warnings should be positive, got %d", opts.MaxWarnings)
+	}
+
+	hasValidFormat := opts.ArchFormat == ""
+	for _, validFormat := range models.ArchFormatValues {
+		if opts.ArchFormat == validFormat {
+			hasValidFormat = true
+			break
+		}
+	}
+
+	if !hasValidFormat {
+		return Options{}, fmt.Errorf("unknown arch format '%s', available: [%s]",
+			opts.ArchFormat,
+			strings.Join(models.ArchFormatValues, ", "),
+		)
+	}
+
+	for _, checkName := range opts.Checks {
+		hasValidName := false
+		for _, validName := range models.CheckersValues {
+			if checkName == validName {
+				hasValidName = true
+				break
+			}
+		}
+
+		if !hasValidName {
+			return Options{}, fmt.Errorf("unknown check '%s', available: [%s]",
+				checkName,
+				strings.Join(models.CheckersValues, ", "),
+			)
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,93 @@
+package archlint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProjectPath = "../../test/check/project"
+
+func TestCheck(t *testing.T) {
+	result, err := Check(context.Background(), Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "arch1_warnings.yml",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.HasWarnings())
+	assert.Equal(t, "github.com/fe3dback/go-arch-lint/test/check/project", result.ModuleName)
+	assert.Empty(t, result.Notices)
+	require.Len(t, result.DependencyWarnings, 1)
+	assert.Equal(t, "c", result.DependencyWarnings[0].ComponentName)
+	assert.Equal(t, "github.com/fe3dback/go-arch-lint/test/check/project/internal/a", result.DependencyWarnings[0].ResolvedImportName)
+	assert.Len(t, result.MatchWarnings, 3)
+	assert.Len(t, result.Messages(), 4)
+}
+
+func TestCheck_ok(t *testing.T) {
+	result, err := Check(context.Background(), Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "arch1_ok.yml",
+	})
+	require.NoError(t, err)
+
+	assert.False(t, result.HasWarnings())
+	assert.Empty(t, result.Messages())
+}
+
+func TestCheck_invalidOptions(t *testing.T) {
+	_, err := Check(context.Background(), Options{
+		ProjectPath: testProjectPath,
+		Checks:      []string{"unknown"},
+	})
+	assert.Error(t, err)
+
+	_, err = Check(context.Background(), Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "not_exist.yml",
+	})
+	assert.Error(t, err)
+}
+
+func TestLoadSpec(t *testing.T) {
+	spec, notices, err := LoadSpec(Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "arch1_ok.yml",
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, notices)
+	assert.Equal(t, "github.com/fe3dback/go-arch-lint/test/check/project", spec.ModuleName)
+	assert.False(t, spec.Allow.DeepScan)
+	assert.NotEmpty(t, spec.Components)
+}
+
+func TestLoadSpec_notices(t *testing.T) {
+	_, notices, err := LoadSpec(Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "arch3_compose_conflict.yml",
+	})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, notices)
+}
+
+func TestMapping(t *testing.T) {
+	result, err := Mapping(context.Background(), Options{
+		ProjectPath: testProjectPath,
+		ArchFile:    "arch1_ok.yml",
+	})
+	require.NoError(t, err)
+
+	components := make(map[string]string)
+	for _, file := range result.Files {
+		components[strings.TrimPrefix(file.FileName, result.ProjectDirectory)] = file.ComponentName
+	}
+
+	assert.Equal(t, "a", components["/internal/a/a1.go"])
+	assert.Equal(t, "nc", components["/internal/not_covered/nc.go"])
+}
@@ -0,0 +1,215 @@
+package archlint
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func convertSpec(spec arch.Spec) Spec {
+	components := make([]Component, 0, len(spec.Components))
+	for _, component := range spec.Components {
+		components = append(components, Component{
+			Name:                component.Name.Value,
+			Owner:               component.Owner.Value,
+			Description:         component.Description.Value,
+			Tags:                convertValues(component.Tags),
+			DeepScan:            component.DeepScan.Value,
+			ImportPaths:         convertResolvedPaths(component.ResolvedPaths),
+			MayDependOn:         convertValues(component.MayDependOn),
+			CanUse:              convertValues(component.CanUse),
+			AllowAllProjectDeps: component.SpecialFlags.AllowAllProjectDeps.Value,
+			AllowAllVendorDeps:  component.SpecialFlags.AllowAllVendorDeps.Value,
+		})
+	}
+
+	exclude := make([]string, 0, len(spec.Exclude))
+	for _, excluded := range spec.Exclude {
+		exclude = append(exclude, excluded.Value.ImportPath)
+	}
+
+	return Spec{
+		ModuleName:       spec.ModuleName.Value,
+		RootDirectory:    spec.RootDirectory.Value,
+		WorkingDirectory: spec.WorkingDirectory.Value,
+		Allow: Allow{
+			DepOnAnyVendor:           spec.Allow.DepOnAnyVendor.Value,
+			DeepScan:                 spec.Allow.DeepScan.Value,
+			DeepScanDepth:            spec.Allow.DeepScanDepth.Value,
+			AllChecks:                spec.Allow.AllChecks.Value,
+			IgnoreNotFoundComponents: spec.Allow.IgnoreNotFoundComponents.Value,
+		},
+		Components: components,
+		Exclude:    exclude,
+	}
+}
+
+func convertNotices(notices []arch.Notice) []Notice {
+	result := make([]Notice, 0, len(notices))
+	for _, notice := range notices {
+		result = append(result, Notice{
+			Text:      notice.Notice.Error(),
+			Reference: convertReference(notice.Ref),
+		})
+	}
+
+	return result
+}
+
+func convertCheckResult(out models.CmdCheckOut) Result {
+	result := Result{
+		ModuleName:             out.ModuleName,
+		Notices:                make([]Notice, 0, len(out.DocumentNotices)),
+		DependencyWarnings:     make([]DependencyWarning, 0, len(out.ArchWarningsDependency)),
+		MatchWarnings:          make([]MatchWarning, 0, len(out.ArchWarningsMatch)),
+		DeepScanWarnings:       make([]DeepScanWarning, 0, len(out.ArchWarningsDeepScan)),
+		DeepScanVendorWarnings: make([]DeepScanVendorWarning, 0, len(out.ArchWarningsDeepScanVendor)),
+		ModuleWarnings:         make([]ModuleWarning, 0, len(out.ArchWarningsModule)),
+		FeatureWarnings:        make([]FeatureWarning, 0, len(out.ArchWarningsFeature)),
+		VisibilityWarnings:     make([]VisibilityWarning, 0, len(out.ArchWarningsVisibility)),
+		OmittedCount:           out.OmittedCount,
+		Qualities:              make([]Quality, 0, len(out.Qualities)),
+	}
+
+	for _, notice := range out.DocumentNotices {
+		result.Notices = append(result.Notices, Notice{
+			Text: notice.Text,
+			Reference: Reference{
+				File:   notice.File,
+				Line:   notice.Line,
+				Column: notice.Column,
+			},
+		})
+	}
+
+	for _, warning := range out.ArchWarningsDependency {
+		result.DependencyWarnings = append(result.DependencyWarnings, DependencyWarning{
+			ComponentName:          warning.ComponentName,
+			ComponentOwner:         warning.ComponentOwner,
+			ImportedComponentName:  warning.ImportedComponentName,
+			ImportedComponentOwner: warning.ImportedComponentOwner,
+			FileRelativePath:       warning.FileRelativePath,
+			FileAbsolutePath:       warning.FileAbsolutePath,
+			ResolvedImportName:     warning.ResolvedImportName,
+			Reason:                 warning.Reason,
+			Reference:              convertReference(warning.Reference),
+		})
+	}
+
+	for _, warning := range out.ArchWarningsMatch {
+		result.MatchWarnings = append(result.MatchWarnings, MatchWarning{
+			FileRelativePath: warning.FileRelativePath,
+			FileAbsolutePath: warning.FileAbsolutePath,
+		})
+	}
+
+	for _, warning := range out.ArchWarningsDeepScan {
+		result.DeepScanWarnings = append(result.DeepScanWarnings, DeepScanWarning{
+			GateComponentName:       warning.Gate.ComponentName,
+			GateMethodName:          warning.Gate.MethodName,
+			GateDefinition:          convertReference(warning.Gate.Definition),
+			DependencyComponentName: warning.Dependency.ComponentName,
+			DependencyName:          warning.Dependency.Name,
+			Injection:               convertReference(warning.Dependency.Injection),
+			Target:                  convertReference(warning.Target.Definition),
+		})
+	}
+
+	for _, warning := range out.ArchWarningsDeepScanVendor {
+		result.DeepScanVendorWarnings = append(result.DeepScanVendorWarnings, DeepScanVendorWarning{
+			GateComponentName: warning.Gate.ComponentName,
+			GateMethodName:    warning.Gate.MethodName,
+			GateDefinition:    convertReference(warning.Gate.Definition),
+			VendorImportPath:  warning.Vendor.ImportPath,
+			VendorName:        warning.Vendor.Name,
+			Injection:         convertReference(warning.Vendor.Injection),
+			Target:            convertReference(warning.Target.Definition),
+		})
+	}
+
+	for _, warning := range out.ArchWarningsFeature {
+		result.FeatureWarnings = append(result.FeatureWarnings, FeatureWarning{
+			ComponentName:    warning.ComponentName,
+			Feature:          warning.Feature,
+			FileRelativePath: warning.FileRelativePath,
+			FileAbsolutePath: warning.FileAbsolutePath,
+			Reference:        convertReference(warning.Reference),
+		})
+	}
+
+	for _, warning := range out.ArchWarningsVisibility {
+		result.VisibilityWarnings = append(result.VisibilityWarnings, VisibilityWarning{
+			ComponentName:        warning.ComponentName,
+			PrivateComponentName: warning.PrivateComponentName,
+			Reason:               warning.Reason,
+			FileRelativePath:     warning.FileRelativePath,
+			FileAbsolutePath:     warning.FileAbsolutePath,
+			Reference:            convertReference(warning.Reference),
+		})
+	}
+
+	for _, warning := range out.ArchWarningsModule {
+		result.ModuleWarnings = append(result.ModuleWarnings, ModuleWarning{
+			VendorName: warning.VendorName,
+			ModulePath: warning.ModulePath,
+			Version:    warning.Version,
+			Reason:     warning.Reason,
+			Reference:  convertReference(warning.Reference),
+		})
+	}
+
+	for _, quality := range out.Qualities {
+		result.Qualities = append(result.Qualities, Quality{
+			ID:       quality.ID,
+			Name:     quality.Name,
+			Checker:  quality.Checker,
+			Used:     quality.Used,
+			Ran:      quality.Ran,
+			Duration: quality.Duration,
+		})
+	}
+
+	return result
+}
+
+func convertMappingResult(out models.CmdMappingOut) MappingResult {
+	files := make([]FileMapping, 0, len(out.MappingList))
+	for _, file := range out.MappingList {
+		files = append(files, FileMapping{
+			FileName:      file.FileName,
+			ComponentName: file.ComponentName,
+		})
+	}
+
+	return MappingResult{
+		ProjectDirectory: out.ProjectDirectory,
+		ModuleName:       out.ModuleName,
+		Files:            files,
+	}
+}
+
+func convertReference(ref common.Reference) Reference {
+	return Reference{
+		File:   ref.File,
+		Line:   ref.Line,
+		Column: ref.Column,
+	}
+}
+
+func convertResolvedPaths(paths []common.Referable[models.ResolvedPath]) []string {
+	result := make([]string, 0, len(paths))
+	for _, resolvedPath := range paths {
+		result = append(result, resolvedPath.Value.ImportPath)
+	}
+
+	return result
+}
+
+func convertValues(values []common.Referable[string]) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.Value)
+	}
+
+	return result
+}
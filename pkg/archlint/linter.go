@@ -0,0 +1,102 @@
+package archlint
+
+import (
+	"github.com/logrusorgru/aurora/v3"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
+	"github.com/fe3dback/go-arch-lint/internal/services/project/scanner"
+	"github.com/fe3dback/go-arch-lint/internal/services/render/code"
+	"github.com/fe3dback/go-arch-lint/internal/services/render/printer"
+	"github.com/fe3dback/go-arch-lint/internal/services/schema"
+	specassembler "github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
+	specvalidator "github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
+)
+
+// Linter is services graph of linter (spec assembling and checkers).
+// It's shared by this package API and by go-arch-lint CLI, so
+// both of them always lint project in same way.
+//
+// Linter is not part of stable API, use Check, LoadSpec and
+// Mapping functions instead
+type Linter struct {
+	archFormat models.ArchFormat
+	useColors  bool
+}
+
+// NewLinter create services graph, useColors affect only
+// source code previews in warnings (terminal output)
+func NewLinter(archFormat models.ArchFormat, useColors bool) *Linter {
+	return &Linter{
+		archFormat: archFormat,
+		useColors:  useColors,
+	}
+}
+
+func (l *Linter) SpecAssembler() *specassembler.Assembler {
+	return specassembler.NewAssembler(
+		l.specDecoder(),
+		specvalidator.NewValidator(path.NewResolver(), l.ProjectScanner()),
+		path.NewResolver(),
+	)
+}
+
+func (l *Linter) SpecChecker() *checker.CompositeChecker {
+	return checker.NewCompositeChecker(
+		l.ImportsChecker(),
+		l.DeepScanChecker(),
+		l.FeaturesChecker(),
+		l.VisibilityChecker(),
+	)
+}
+
+func (l *Linter) ImportsChecker() *checker.Imports {
+	return checker.NewImport(l.ProjectFilesResolver())
+}
+
+func (l *Linter) DeepScanChecker() *checker.DeepScan {
+	return checker.NewDeepScan(l.ProjectFilesResolver(), l.ReferenceRender())
+}
+
+func (l *Linter) FeaturesChecker() *checker.Features {
+	return checker.NewFeatures(l.ProjectFilesResolver())
+}
+
+func (l *Linter) VisibilityChecker() *checker.Visibility {
+	return checker.NewVisibility(l.ProjectFilesResolver())
+}
+
+func (l *Linter) ProjectFilesResolver() *resolver.Resolver {
+	return resolver.NewResolver(
+		l.ProjectScanner(),
+		holder.NewHolder(),
+	)
+}
+
+func (l *Linter) ProjectScanner() *scanner.Scanner {
+	return scanner.NewScanner()
+}
+
+func (l *Linter) ProjectInfoAssembler() *info.Assembler {
+	return info.NewAssembler()
+}
+
+func (l *Linter) ReferenceRender() *code.Render {
+	return code.NewRender(
+		printer.NewColorPrinter(aurora.NewAurora(l.useColors)),
+	)
+}
+
+func (l *Linter) specDecoder() *decoder.Decoder {
+	return decoder.NewDecoder(
+		reference.NewResolver(),
+		schema.NewProvider(),
+		l.archFormat,
+	)
+}
@@ -0,0 +1,7 @@
+module example.com/analyzer
+
+go 1.18
+
+require localvendor v0.0.0
+
+replace localvendor => ./localvendor
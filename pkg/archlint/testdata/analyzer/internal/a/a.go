@@ -0,0 +1,3 @@
+package a // want package:"component\\(a\\)"
+
+const A = "a"
@@ -0,0 +1,11 @@
+package b // want package:"component\\(b\\)"
+
+import (
+	"fmt"
+
+	"example.com/analyzer/internal/a"
+)
+
+func B() string {
+	return fmt.Sprint(a.A)
+}
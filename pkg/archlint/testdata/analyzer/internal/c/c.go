@@ -0,0 +1,10 @@
+package c // want package:"component\\(c\\)"
+
+import (
+	"example.com/analyzer/internal/a" // want "Component c shouldn't depend on example.com/analyzer/internal/a \\(component a\\)"
+	"example.com/analyzer/internal/b" // want "Component c shouldn't depend on example.com/analyzer/internal/b \\(component b\\)"
+)
+
+func C() string {
+	return a.A + b.B()
+}
@@ -0,0 +1,5 @@
+package c
+
+import "example.com/analyzer/internal/a"
+
+var ignored = a.A
@@ -0,0 +1,11 @@
+package d // want package:"component\\(d\\)"
+
+import (
+	"strings"
+
+	"localvendor" // want "Component d shouldn't depend on localvendor"
+)
+
+func D() string {
+	return strings.ToUpper(localvendor.Name)
+}
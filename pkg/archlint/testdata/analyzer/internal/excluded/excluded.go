@@ -0,0 +1,5 @@
+package excluded
+
+import "example.com/analyzer/internal/c"
+
+var Excluded = c.C()
@@ -0,0 +1,5 @@
+package notattached
+
+import "example.com/analyzer/internal/c"
+
+var NotAttached = c.C()
@@ -0,0 +1,3 @@
+module localvendor
+
+go 1.18
@@ -0,0 +1,3 @@
+package localvendor
+
+const Name = "localvendor"
@@ -0,0 +1,300 @@
+package archlint
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	CheckImports    = "imports"
+	CheckDeepScan   = "deepscan"
+	CheckFeatures   = "features"
+	CheckVisibility = "visibility"
+)
+
+const (
+	ArchFormatYAML = "yaml"
+	ArchFormatJSON = "json"
+	ArchFormatTOML = "toml"
+)
+
+type (
+	// Options describe which project and arch file will be linted.
+	// All fields are optional, zero values will be replaced with
+	// same defaults, as used in CLI
+	Options struct {
+		// ProjectPath is path to project directory with go.mod (default "./")
+		ProjectPath string
+
+		// ArchFile is path to arch file, relative to ProjectPath (default ".go-arch-lint.yml")
+		ArchFile string
+
+		// ArchFormat is one of ArchFormat* constants (default detected by file extension)
+		ArchFormat string
+
+		// MaxWarnings is max number of warnings in Result (default 512)
+		MaxWarnings int
+
+		// Checks is list of Check* constants to run (default all)
+		Checks []string
+
+		// AllChecks will run all checkers, even when previous checker found warnings
+		AllChecks bool
+
+		// Owner will keep only warnings, related to components of this owner (default all)
+		Owner string
+	}
+
+	Reference struct {
+		File   string
+		Line   int
+		Column int
+	}
+
+	// Notice is problem in arch file itself (invalid syntax, unknown component, etc..)
+	Notice struct {
+		Text      string
+		Reference Reference
+	}
+
+	Spec struct {
+		ModuleName       string
+		RootDirectory    string
+		WorkingDirectory string
+		Allow            Allow
+		Components       []Component
+		Exclude          []string
+	}
+
+	Allow struct {
+		DepOnAnyVendor           bool
+		DeepScan                 bool
+		DeepScanDepth            int
+		AllChecks                bool
+		IgnoreNotFoundComponents bool
+	}
+
+	Component struct {
+		Name                string
+		Owner               string // from spec, or CODEOWNERS file
+		Description         string
+		Tags                []string
+		DeepScan            bool
+		ImportPaths         []string
+		MayDependOn         []string
+		CanUse              []string
+		AllowAllProjectDeps bool
+		AllowAllVendorDeps  bool
+	}
+
+	// Result is output of Check, same as `go-arch-lint check --json`
+	Result struct {
+		ModuleName             string
+		Notices                []Notice
+		DependencyWarnings     []DependencyWarning
+		MatchWarnings          []MatchWarning
+		DeepScanWarnings       []DeepScanWarning
+		DeepScanVendorWarnings []DeepScanVendorWarning
+		ModuleWarnings         []ModuleWarning
+		FeatureWarnings        []FeatureWarning
+		VisibilityWarnings     []VisibilityWarning
+		OmittedCount           int
+		Qualities              []Quality
+	}
+
+	// DependencyWarning is not allowed import of component
+	DependencyWarning struct {
+		ComponentName          string
+		ComponentOwner         string
+		ImportedComponentName  string // empty for vendor and std imports
+		ImportedComponentOwner string
+		FileRelativePath       string
+		FileAbsolutePath       string
+		ResolvedImportName     string
+		Reason                 string // empty, when import is not allowed by mayDependOn (or canUse) rules
+		Reference              Reference
+	}
+
+	// MatchWarning is project file, not attached to any component
+	MatchWarning struct {
+		FileRelativePath string
+		FileAbsolutePath string
+	}
+
+	// DeepScanWarning is not allowed component injection into method call
+	DeepScanWarning struct {
+		GateComponentName       string
+		GateMethodName          string
+		GateDefinition          Reference
+		DependencyComponentName string
+		DependencyName          string
+		Injection               Reference
+		Target                  Reference
+	}
+
+	// DeepScanVendorWarning is not allowed vendor injection into method call
+	DeepScanVendorWarning struct {
+		GateComponentName string
+		GateMethodName    string
+		GateDefinition    Reference
+		VendorImportPath  string
+		VendorName        string
+		Injection         Reference
+		Target            Reference
+	}
+
+	// ModuleWarning is go.mod requirement, not matched to vendor rules
+	ModuleWarning struct {
+		VendorName string
+		ModulePath string
+		Version    string
+		Reason     string
+		Reference  Reference // line in go.mod
+	}
+
+	// FeatureWarning is low level feature (cgo, unsafe, reflect,
+	// linkname, embed) usage, not allowed for component
+	FeatureWarning struct {
+		ComponentName    string
+		Feature          string
+		FileRelativePath string
+		FileAbsolutePath string
+		Reference        Reference
+	}
+
+	// VisibilityWarning is private component import outside of its
+	// parent directory, or private type in exported API of other component
+	VisibilityWarning struct {
+		ComponentName        string
+		PrivateComponentName string
+		Reason               string
+		FileRelativePath     string
+		FileAbsolutePath     string
+		Reference            Reference
+	}
+
+	Quality struct {
+		ID       string
+		Name     string
+		Checker  string
+		Used     bool
+		Ran      bool
+		Duration time.Duration
+	}
+
+	// MappingResult is output of Mapping, same as `go-arch-lint mapping --json`
+	MappingResult struct {
+		ProjectDirectory string
+		ModuleName       string
+		Files            []FileMapping
+	}
+
+	FileMapping struct {
+		FileName      string // absolute path
+		ComponentName string // empty, when file not attached to any component
+	}
+)
+
+// HasWarnings is true, when project not match arch
+// file, or arch file itself is not valid
+func (r Result) HasWarnings() bool {
+	return len(r.Notices) > 0 ||
+		len(r.DependencyWarnings) > 0 ||
+		len(r.MatchWarnings) > 0 ||
+		len(r.DeepScanWarnings) > 0 ||
+		len(r.DeepScanVendorWarnings) > 0 ||
+		len(r.ModuleWarnings) > 0 ||
+		len(r.FeatureWarnings) > 0 ||
+		len(r.VisibilityWarnings) > 0
+}
+
+// Messages return human-readable list of all notices and warnings,
+// useful for assertions in tests
+func (r Result) Messages() []string {
+	messages := make([]string, 0)
+
+	for _, notice := range r.Notices {
+		messages = append(messages, fmt.Sprintf("%s (%s)", notice.Text, notice.Reference))
+	}
+
+	for _, warning := range r.DependencyWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Reference))
+	}
+
+	for _, warning := range r.MatchWarnings {
+		messages = append(messages, warning.Message())
+	}
+
+	for _, warning := range r.DeepScanWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Injection))
+	}
+
+	for _, warning := range r.DeepScanVendorWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Injection))
+	}
+
+	for _, warning := range r.FeatureWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Reference))
+	}
+
+	for _, warning := range r.VisibilityWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Reference))
+	}
+
+	for _, warning := range r.ModuleWarnings {
+		messages = append(messages, fmt.Sprintf("%s in %s", warning.Message(), warning.Reference))
+	}
+
+	return messages
+}
+
+func (w DependencyWarning) Message() string {
+	if w.Reason != "" {
+		return fmt.Sprintf("Component %s shouldn't depend on %s (%s)", w.ComponentName, w.ResolvedImportName, w.Reason)
+	}
+
+	return fmt.Sprintf("Component %s shouldn't depend on %s", w.ComponentName, w.ResolvedImportName)
+}
+
+func (w MatchWarning) Message() string {
+	return fmt.Sprintf("File %s not attached to any component in archfile", w.FileRelativePath)
+}
+
+func (w DeepScanWarning) Message() string {
+	return fmt.Sprintf("Dependency %s -\\-> %s not allowed (%s injected into %s)",
+		w.DependencyComponentName,
+		w.GateComponentName,
+		w.DependencyName,
+		w.GateMethodName,
+	)
+}
+
+func (w DeepScanVendorWarning) Message() string {
+	return fmt.Sprintf("Vendor %s -\\-> %s not allowed (%s injected into %s)",
+		w.VendorImportPath,
+		w.GateComponentName,
+		w.VendorName,
+		w.GateMethodName,
+	)
+}
+
+func (w ModuleWarning) Message() string {
+	return fmt.Sprintf("Module %s %s (vendor %s): %s",
+		w.ModulePath,
+		w.Version,
+		w.VendorName,
+		w.Reason,
+	)
+}
+
+func (w FeatureWarning) Message() string {
+	return fmt.Sprintf("Component %s shouldn't use %s", w.ComponentName, w.Feature)
+}
+
+func (w VisibilityWarning) Message() string {
+	return fmt.Sprintf("Component %s -> private %s: %s", w.ComponentName, w.PrivateComponentName, w.Reason)
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s:%d", r.File, r.Line)
+}
@@ -0,0 +1,222 @@
+// Package golangci is golangci-lint module plugin, it runs same
+// checks as `go-arch-lint check` and reports warnings as golangci issues.
+//
+// .custom-gcl.yml:
+//
+//	plugins:
+//	  - module: github.com/fe3dback/go-arch-lint
+//	    import: github.com/fe3dback/go-arch-lint/pkg/golangci
+//	    version: latest
+//
+// .golangci.yml:
+//
+//	linters-settings:
+//	  custom:
+//	    goarchlint:
+//	      type: module
+//	      settings:
+//	        arch-file: .go-arch-lint.yml
+//	        deep-scan: true
+//	        max-warnings: 512
+package golangci
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"sync"
+
+	"github.com/golangci/plugin-module-register/register"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/fe3dback/go-arch-lint/pkg/archlint"
+)
+
+const linterName = "goarchlint"
+
+func init() {
+	register.Plugin(linterName, New)
+}
+
+type (
+	Settings struct {
+		// ProjectPath is project directory, default is nearest
+		// go.mod directory of linted package
+		ProjectPath string `json:"project-path"`
+		ArchFile    string `json:"arch-file"`
+
+		// DeepScan is enabled by default, when false, deepscan checker
+		// is skipped (even with `allow.deepScan: true` in arch file)
+		DeepScan    *bool `json:"deep-scan"`
+		MaxWarnings int   `json:"max-warnings"`
+	}
+
+	Plugin struct {
+		settings Settings
+
+		mux     sync.Mutex
+		results map[string]projectResult // project directory -> warnings
+	}
+
+	projectResult struct {
+		warnings map[string][]warning // absolute file path -> warnings
+		err      error
+	}
+
+	warning struct {
+		line    int
+		column  int
+		message string
+	}
+)
+
+func New(conf any) (register.LinterPlugin, error) {
+	settings, err := register.DecodeSettings[Settings](conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s settings: %w", linterName, err)
+	}
+
+	return &Plugin{
+		settings: settings,
+		results:  make(map[string]projectResult),
+	}, nil
+}
+
+func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{
+		{
+			Name: linterName,
+			Doc:  "check project architecture by go-arch-lint arch file",
+			URL:  "https://github.com/fe3dback/go-arch-lint",
+			Run:  p.run,
+		},
+	}, nil
+}
+
+func (p *Plugin) GetLoadMode() string {
+	return register.LoadModeSyntax
+}
+
+func (p *Plugin) run(pass *analysis.Pass) (any, error) {
+	if len(pass.Files) == 0 {
+		return nil, nil
+	}
+
+	projectPath := p.settings.ProjectPath
+	if projectPath == "" {
+		projectPath = archlint.NearestModuleDirectory(filepath.Dir(pass.Fset.Position(pass.Files[0].Package).Filename))
+	}
+
+	if projectPath == "" {
+		return nil, nil
+	}
+
+	result := p.check(projectPath)
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	for _, file := range pass.Files {
+		fileName := pass.Fset.Position(file.Package).Filename
+		for _, fileWarning := range result.warnings[fileName] {
+			pass.Reportf(position(pass.Fset, file, fileWarning), "%s", fileWarning.message)
+		}
+	}
+
+	return nil, nil
+}
+
+// check will lint whole project once, golangci run analyzer for each
+// package, but checkers is working with all project files at once
+func (p *Plugin) check(projectPath string) projectResult {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if result, exist := p.results[projectPath]; exist {
+		return result
+	}
+
+	opts := archlint.Options{
+		ProjectPath: projectPath,
+		ArchFile:    p.settings.ArchFile,
+		MaxWarnings: p.settings.MaxWarnings,
+		AllChecks:   true,
+	}
+
+	if p.settings.DeepScan != nil && !*p.settings.DeepScan {
+		opts.Checks = []string{archlint.CheckImports, archlint.CheckFeatures, archlint.CheckVisibility}
+	}
+
+	checkResult, err := archlint.Check(context.Background(), opts)
+	if err != nil {
+		result := projectResult{err: fmt.Errorf("%s: %w", linterName, err)}
+		p.results[projectPath] = result
+		return result
+	}
+
+	result := projectResult{warnings: collectWarnings(checkResult)}
+	if len(checkResult.Notices) > 0 {
+		notice := checkResult.Notices[0]
+		result.err = fmt.Errorf("%s: invalid arch file: %s (%s)", linterName, notice.Text, notice.Reference)
+	}
+
+	p.results[projectPath] = result
+	return result
+}
+
+func collectWarnings(result archlint.Result) map[string][]warning {
+	warnings := make(map[string][]warning)
+	add := func(ref archlint.Reference, message string) {
+		warnings[ref.File] = append(warnings[ref.File], warning{
+			line:    ref.Line,
+			column:  ref.Column,
+			message: message,
+		})
+	}
+
+	for _, dependencyWarning := range result.DependencyWarnings {
+		add(dependencyWarning.Reference, dependencyWarning.Message())
+	}
+
+	for _, matchWarning := range result.MatchWarnings {
+		// reported at package clause
+		add(archlint.Reference{File: matchWarning.FileAbsolutePath}, matchWarning.Message())
+	}
+
+	for _, deepScanWarning := range result.DeepScanWarnings {
+		add(deepScanWarning.Injection, deepScanWarning.Message())
+	}
+
+	for _, deepScanWarning := range result.DeepScanVendorWarnings {
+		add(deepScanWarning.Injection, deepScanWarning.Message())
+	}
+
+	for _, featureWarning := range result.FeatureWarnings {
+		add(featureWarning.Reference, featureWarning.Message())
+	}
+
+	for _, visibilityWarning := range result.VisibilityWarnings {
+		add(visibilityWarning.Reference, visibilityWarning.Message())
+	}
+
+	// module warnings is reported in go.mod, golangci-lint is
+	// not analyze it, so they are visible only in `go-arch-lint check`
+
+	return warnings
+}
+
+func position(fset *token.FileSet, file *ast.File, fileWarning warning) token.Pos {
+	tokenFile := fset.File(file.Package)
+	if tokenFile == nil || fileWarning.line <= 0 || fileWarning.line > tokenFile.LineCount() {
+		return file.Package
+	}
+
+	pos := tokenFile.LineStart(fileWarning.line)
+	if fileWarning.column > 1 {
+		pos += token.Pos(fileWarning.column - 1)
+	}
+
+	return pos
+}
@@ -0,0 +1,30 @@
+package golangci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestPlugin(t *testing.T) {
+	plugin, err := New(map[string]any{
+		"deep-scan":    false,
+		"max-warnings": 10,
+	})
+	require.NoError(t, err)
+
+	analyzers, err := plugin.BuildAnalyzers()
+	require.NoError(t, err)
+	require.Len(t, analyzers, 1)
+
+	analysistest.Run(t, "testdata/project", analyzers[0], "./...")
+}
+
+func TestPlugin_invalidSettings(t *testing.T) {
+	_, err := New(map[string]any{
+		"unknown": true,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,11 @@
+package c
+
+import (
+	"fmt"
+
+	"example.com/project/internal/a" // want "Component c shouldn't depend on example.com/project/internal/a"
+)
+
+func C() string {
+	return fmt.Sprint(a.A)
+}
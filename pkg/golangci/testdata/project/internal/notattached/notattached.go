@@ -0,0 +1,3 @@
+package notattached // want "File /internal/notattached/notattached.go not attached to any component in archfile"
+
+const NotAttached = "-"
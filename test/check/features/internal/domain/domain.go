@@ -0,0 +1,17 @@
+package domain
+
+import (
+	_ "embed"
+	"reflect"
+	_ "unsafe"
+)
+
+//go:embed domain.txt
+var description string
+
+//go:linkname fastrand runtime.fastrand
+func fastrand() uint32
+
+func Describe(value any) string {
+	return reflect.TypeOf(value).String() + description
+}
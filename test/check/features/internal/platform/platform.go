@@ -0,0 +1,20 @@
+package platform
+
+// #include <stdlib.h>
+import "C"
+
+import (
+	"unsafe"
+	_ "unsafe"
+)
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+func Now() int64 {
+	return nanotime()
+}
+
+func Free(ptr unsafe.Pointer) {
+	C.free(ptr)
+}
@@ -0,0 +1,12 @@
+package admin
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/importers/internal/billing"
+	"github.com/fe3dback/go-arch-lint/test/check/importers/internal/billing/api"
+	"github.com/fe3dback/go-arch-lint/test/check/importers/internal/billing/ledger"
+)
+
+func Refund(invoice api.Invoice) billing.Refund {
+	ledger.Add(-invoice.Amount)
+	return billing.Refund{Amount: invoice.Amount}
+}
@@ -0,0 +1,5 @@
+package api
+
+type Invoice struct {
+	Amount int
+}
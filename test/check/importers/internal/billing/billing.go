@@ -0,0 +1,5 @@
+package billing
+
+type Refund struct {
+	Amount int
+}
@@ -0,0 +1,7 @@
+package ledger
+
+var total int
+
+func Add(amount int) {
+	total += amount
+}
@@ -0,0 +1,10 @@
+package checkout
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/importers/internal/billing/api"
+	"github.com/fe3dback/go-arch-lint/test/check/importers/internal/billing/ledger"
+)
+
+func Pay(invoice api.Invoice) {
+	ledger.Add(invoice.Amount)
+}
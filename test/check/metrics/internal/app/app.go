@@ -0,0 +1,18 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/metrics/internal/domain"
+	"github.com/fe3dback/go-arch-lint/test/check/metrics/internal/infra"
+)
+
+type App struct {
+	clock domain.Clock
+	repo  domain.Repository[int64]
+}
+
+func New() *App {
+	return &App{
+		clock: infra.SystemClock{},
+		repo:  &infra.MemoryRepository{},
+	}
+}
@@ -0,0 +1,22 @@
+package domain
+
+type (
+	ID interface {
+		~int64 | ~string
+	}
+
+	Clock interface {
+		Now() int64
+	}
+
+	Repository[T ID] interface {
+		Find(id T) (User, error)
+	}
+
+	User struct {
+		Name string
+	}
+
+	// Users is alias, not counted as new type
+	Users = []User
+)
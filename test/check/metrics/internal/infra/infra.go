@@ -0,0 +1,19 @@
+package infra
+
+import "github.com/fe3dback/go-arch-lint/test/check/metrics/internal/domain"
+
+type (
+	SystemClock struct{}
+
+	MemoryRepository struct {
+		users map[int64]domain.User
+	}
+)
+
+func (SystemClock) Now() int64 {
+	return 0
+}
+
+func (r *MemoryRepository) Find(id int64) (domain.User, error) {
+	return r.users[id], nil
+}
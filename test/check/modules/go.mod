@@ -0,0 +1,12 @@
+module github.com/fe3dback/go-arch-lint/test/check/modules
+
+go 1.18
+
+require (
+	github.com/google/uuid v1.3.0
+	github.com/pkg/errors v0.9.1
+	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/google/uuid => ../uuid
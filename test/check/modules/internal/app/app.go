@@ -0,0 +1,13 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+func Run() error {
+	_, err := http.Get("https://example.com/" + uuid.NewString())
+	return errors.Wrap(err, "failed run")
+}
@@ -0,0 +1,13 @@
+package storage
+
+import (
+	"log"
+
+	"gopkg.in/yaml.v2"
+)
+
+func Load(data []byte) (map[string]any, error) {
+	result := make(map[string]any)
+	log.Println("loading data")
+	return result, yaml.Unmarshal(data, &result)
+}
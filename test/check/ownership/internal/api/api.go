@@ -0,0 +1,7 @@
+package api
+
+import "github.com/fe3dback/go-arch-lint/test/check/ownership/internal/storage"
+
+type Handler struct {
+	Repo *storage.Repo
+}
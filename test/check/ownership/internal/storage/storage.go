@@ -0,0 +1,11 @@
+package storage
+
+import "github.com/fe3dback/go-arch-lint/test/check/ownership/internal/storage/db"
+
+type Repo struct {
+	conn *db.Conn
+}
+
+func NewRepo() *Repo {
+	return &Repo{conn: db.Open()}
+}
@@ -0,0 +1,31 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/services"
+)
+
+func ProvideHandlerFactory() *services.Handler {
+	return services.NewHandler(provideRepository())
+}
+
+func ProvideHandlerVariable() *services.Handler {
+	repo, _ := provideRepositoryWithErr()
+	return services.NewHandler(repo)
+}
+
+func ProvideHandlerClosure() *services.Handler {
+	provide := func() services.Repository {
+		return repository.NewMemory()
+	}
+
+	return services.NewHandler(provide())
+}
+
+func provideRepository() services.Repository {
+	return repository.NewMemory()
+}
+
+func provideRepositoryWithErr() (services.Repository, error) {
+	return provideRepository(), nil
+}
@@ -0,0 +1,28 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/services"
+)
+
+func ProvideGenericHandler() *services.GenericHandler[*repository.Memory] {
+	return services.NewGenericHandler(repository.NewMemory())
+}
+
+func ProvideGenericHandlerExplicit() *services.GenericHandler[*repository.Memory] {
+	return services.NewGenericHandler[*repository.Memory](repository.NewMemory())
+}
+
+func ProvideWorkerMethod() *services.Worker {
+	return services.NewWorker(repository.NewMemory().Fetch)
+}
+
+func ProvideWorkerFunc() *services.Worker {
+	return services.NewWorker(repository.FetchDefault)
+}
+
+func ProvideWorkerLiteral() *services.Worker {
+	return services.NewWorker(func() string {
+		return "literal"
+	})
+}
@@ -0,0 +1,23 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/repository"
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/services"
+)
+
+func ProvideServiceLiteral() *services.Service {
+	return &services.Service{
+		Repo: repository.NewMemory(),
+	}
+}
+
+func ProvideServiceAssign() *services.Service {
+	svc := &services.Service{}
+	svc.Cache = repository.NewMemory()
+
+	return svc
+}
+
+func ProvideEmbedded() services.Embedded {
+	return services.Embedded{repository.NewMemory()}
+}
@@ -0,0 +1,16 @@
+package container
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/fe3dback/go-arch-lint/test/check/project/deepscan/services"
+)
+
+func ProvideBufferLogger() *services.Logger {
+	return services.NewLogger(&bytes.Buffer{})
+}
+
+func ProvideStdoutLogger() *services.Logger {
+	return services.NewLogger(os.Stdout)
+}
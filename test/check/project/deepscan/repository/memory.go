@@ -0,0 +1,15 @@
+package repository
+
+type Memory struct{}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Fetch() string {
+	return "memory"
+}
+
+func FetchDefault() string {
+	return "default"
+}
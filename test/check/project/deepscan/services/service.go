@@ -0,0 +1,63 @@
+package services
+
+import "io"
+
+type (
+	Repository interface {
+		Fetch() string
+	}
+
+	Service struct {
+		Repo  Repository
+		Cache Repository
+		name  string
+	}
+
+	Embedded struct {
+		Repository
+	}
+)
+
+type Handler struct {
+	repo Repository
+}
+
+func NewHandler(repo Repository) *Handler {
+	return &Handler{repo: repo}
+}
+
+type GenericHandler[R Repository] struct {
+	repo R
+}
+
+func NewGenericHandler[R Repository](repo R) *GenericHandler[R] {
+	return &GenericHandler[R]{repo: repo}
+}
+
+type Worker struct {
+	job func() string
+}
+
+func NewWorker(job func() string) *Worker {
+	return &Worker{job: job}
+}
+
+type Logger struct {
+	out io.Writer
+}
+
+func NewLogger(out io.Writer) *Logger {
+	return &Logger{out: out}
+}
+
+type Notifier interface {
+	Notify(message string)
+}
+
+type Alerts struct {
+	notifier Notifier
+}
+
+func NewAlerts(notifier Notifier) *Alerts {
+	return &Alerts{notifier: notifier}
+}
@@ -1,3 +1,3 @@
 module github.com/fe3dback/go-arch-lint/test/check/project
 
-go 1.13
+go 1.18
@@ -0,0 +1,7 @@
+package app
+
+import "github.com/fe3dback/go-arch-lint/test/check/project/templates/services/billing/domain"
+
+func Handle() domain.Entity {
+	return domain.Entity{}
+}
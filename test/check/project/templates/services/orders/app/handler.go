@@ -0,0 +1,10 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project/templates/services/billing/domain"
+	ordersDomain "github.com/fe3dback/go-arch-lint/test/check/project/templates/services/orders/domain"
+)
+
+func Handle() (ordersDomain.Entity, domain.Entity) {
+	return ordersDomain.Entity{}, domain.Entity{} // not allowed billing domain
+}
@@ -0,0 +1,5 @@
+package domain
+
+type Entity struct {
+	ID int
+}
@@ -0,0 +1,12 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/visibility/internal/api"
+	"github.com/fe3dback/go-arch-lint/test/check/visibility/internal/storage"
+	"github.com/fe3dback/go-arch-lint/test/check/visibility/internal/storage/db"
+)
+
+func Run() {
+	_ = api.Handler{Repo: storage.NewRepo()}
+	_ = db.Open()
+}
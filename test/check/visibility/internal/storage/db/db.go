@@ -0,0 +1,7 @@
+package db
+
+type Conn struct{}
+
+func Open() *Conn {
+	return &Conn{}
+}
@@ -0,0 +1,20 @@
+package storage
+
+import "github.com/fe3dback/go-arch-lint/test/check/visibility/internal/storage/db"
+
+type Repo struct {
+	Conn *db.Conn
+	conn *db.Conn
+}
+
+func NewRepo() *Repo {
+	return &Repo{conn: db.Open()}
+}
+
+func (r *Repo) Connections() map[string][]*db.Conn {
+	return map[string][]*db.Conn{"main": {r.conn}}
+}
+
+func (r *Repo) Ping() error {
+	return nil
+}